@@ -0,0 +1,129 @@
+package vlc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/zivkovicmilos/go-vlc/client"
+)
+
+const baseArt = "art"
+
+const itemKey = "item"
+
+// ErrNoArt is returned when the requested item has no album art
+var ErrNoArt = errors.New("no album art")
+
+// Art is the album art image of a playlist item
+type Art struct {
+	ContentType string // the image MIME type (image/jpeg, image/png...)
+	Data        []byte // the raw image
+}
+
+// artParams returns the art request params for the given item.
+// A nil item ID references the currently playing item
+func artParams(itemID *int) paramMap {
+	if itemID == nil {
+		return nil
+	}
+
+	return paramMap{
+		itemKey: strconv.Itoa(*itemID),
+	}
+}
+
+// openArt requests the album art, and returns its stream along with the content type.
+// Clients that don't support streaming have the art read into memory, with the content type detected
+func (v *VLC) openArt(ctx context.Context, itemID *int) (io.ReadCloser, string, error) {
+	endpoint := buildQueryEndpoint(baseArt, artParams(itemID))
+
+	body, contentType, err := client.Stream(ctx, v.client, endpoint)
+	if err != nil {
+		// VLC responds with 404 if the item has no (readable) art
+		var statusErr *client.StatusError
+		if errors.As(err, &statusErr) && statusErr.Code == http.StatusNotFound {
+			return nil, "", fmt.Errorf("unable to fetch art, %s, %w, %w", endpoint, ErrNoArt, err)
+		}
+
+		return nil, "", fmt.Errorf("unable to execute request, %s, %w", endpoint, err)
+	}
+
+	return body, contentType, nil
+}
+
+// readArt requests the album art, and reads it into memory
+func (v *VLC) readArt(ctx context.Context, itemID *int) (*Art, error) {
+	body, contentType, err := v.openArt(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		_ = body.Close()
+	}()
+
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read art, %w", err)
+	}
+
+	if len(data) == 0 {
+		return nil, ErrNoArt
+	}
+
+	return &Art{
+		ContentType: contentType,
+		Data:        data,
+	}, nil
+}
+
+// GetArt fetches the album art of the given playlist item.
+// Returns ErrNoArt if the item has no album art
+func (v *VLC) GetArt(itemID int) (*Art, error) {
+	return v.GetArtContext(context.Background(), itemID)
+}
+
+// GetArtContext is GetArt with a context that controls the request lifetime
+func (v *VLC) GetArtContext(ctx context.Context, itemID int) (*Art, error) {
+	return v.readArt(ctx, &itemID)
+}
+
+// GetCurrentArt fetches the album art of the currently playing item.
+// Returns ErrNoArt if the item has no album art
+func (v *VLC) GetCurrentArt() (*Art, error) {
+	return v.GetCurrentArtContext(context.Background())
+}
+
+// GetCurrentArtContext is GetCurrentArt with a context that controls the request lifetime
+func (v *VLC) GetCurrentArtContext(ctx context.Context) (*Art, error) {
+	return v.readArt(ctx, nil)
+}
+
+// GetArtStream opens the album art of the given playlist item, and returns the image stream
+// along with its content type. The caller is responsible for closing the stream.
+//
+// The art is only streamed if the client implements client.StreamClient,
+// otherwise it is read into memory first
+func (v *VLC) GetArtStream(itemID int) (io.ReadCloser, string, error) {
+	return v.GetArtStreamContext(context.Background(), itemID)
+}
+
+// GetArtStreamContext is GetArtStream with a context that controls the request lifetime
+func (v *VLC) GetArtStreamContext(ctx context.Context, itemID int) (io.ReadCloser, string, error) {
+	return v.openArt(ctx, &itemID)
+}
+
+// GetCurrentArtStream opens the album art of the currently playing item, and returns the image stream
+// along with its content type. The caller is responsible for closing the stream
+func (v *VLC) GetCurrentArtStream() (io.ReadCloser, string, error) {
+	return v.GetCurrentArtStreamContext(context.Background())
+}
+
+// GetCurrentArtStreamContext is GetCurrentArtStream with a context that controls the request lifetime
+func (v *VLC) GetCurrentArtStreamContext(ctx context.Context) (io.ReadCloser, string, error) {
+	return v.openArt(ctx, nil)
+}
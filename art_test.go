@@ -0,0 +1,287 @@
+package vlc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zivkovicmilos/go-vlc/client"
+)
+
+// pngHeader is the PNG file signature
+var pngHeader = []byte("\x89PNG\r\n\x1a\n")
+
+func TestVLC_GetArt(t *testing.T) {
+	t.Parallel()
+
+	t.Run("art fetched", func(t *testing.T) {
+		t.Parallel()
+
+		mockClient := &mockClient{
+			getFn: func(endpoint string) ([]byte, error) {
+				require.Equal(t, buildQueryEndpoint(baseArt, paramMap{itemKey: "4"}), endpoint)
+
+				return pngHeader, nil
+			},
+		}
+
+		vlc := NewVLC(mockClient)
+
+		art, err := vlc.GetArt(4)
+		require.NoError(t, err)
+
+		assert.Equal(t, pngHeader, art.Data)
+		assert.Equal(t, "image/png", art.ContentType)
+	})
+
+	t.Run("item has no art", func(t *testing.T) {
+		t.Parallel()
+
+		mockClient := &mockClient{
+			getFn: func(endpoint string) ([]byte, error) {
+				return nil, &client.StatusError{
+					Endpoint: endpoint,
+					Body:     "Error",
+					Code:     http.StatusNotFound,
+				}
+			},
+		}
+
+		vlc := NewVLC(mockClient)
+
+		art, err := vlc.GetArt(4)
+
+		assert.Nil(t, art)
+		assert.ErrorIs(t, err, ErrNoArt)
+
+		var statusErr *client.StatusError
+
+		assert.ErrorAs(t, err, &statusErr)
+	})
+
+	t.Run("empty art", func(t *testing.T) {
+		t.Parallel()
+
+		mockClient := &mockClient{
+			getFn: func(_ string) ([]byte, error) {
+				return []byte{}, nil
+			},
+		}
+
+		vlc := NewVLC(mockClient)
+
+		art, err := vlc.GetArt(4)
+
+		assert.Nil(t, art)
+		assert.ErrorIs(t, err, ErrNoArt)
+	})
+
+	t.Run("unable to fetch art", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			fetchErr   = errors.New("fetch error")
+			mockClient = &mockClient{
+				getFn: func(_ string) ([]byte, error) {
+					return nil, fetchErr
+				},
+			}
+		)
+
+		vlc := NewVLC(mockClient)
+
+		art, err := vlc.GetArt(4)
+
+		assert.Nil(t, art)
+		assert.ErrorIs(t, err, fetchErr)
+		assert.NotErrorIs(t, err, ErrNoArt)
+	})
+
+	t.Run("art streamed", func(t *testing.T) {
+		t.Parallel()
+
+		mockClient := &mockStreamClient{
+			mockClient: mockClient{
+				getFn: func(_ string) ([]byte, error) {
+					t.Fatal("streaming client not used")
+
+					return nil, nil
+				},
+			},
+			getStreamContextFn: func(_ context.Context, endpoint string) (io.ReadCloser, string, error) {
+				require.Equal(t, buildQueryEndpoint(baseArt, paramMap{itemKey: "4"}), endpoint)
+
+				return io.NopCloser(strings.NewReader("jpeg")), "image/jpeg", nil
+			},
+		}
+
+		vlc := NewVLC(mockClient)
+
+		art, err := vlc.GetArt(4)
+		require.NoError(t, err)
+
+		assert.Equal(t, []byte("jpeg"), art.Data)
+		assert.Equal(t, "image/jpeg", art.ContentType)
+	})
+}
+
+func TestVLC_GetCurrentArt(t *testing.T) {
+	t.Parallel()
+
+	mockClient := &mockClient{
+		getFn: func(endpoint string) ([]byte, error) {
+			require.Equal(t, baseArt, endpoint)
+
+			return pngHeader, nil
+		},
+	}
+
+	vlc := NewVLC(mockClient)
+
+	art, err := vlc.GetCurrentArt()
+	require.NoError(t, err)
+
+	assert.Equal(t, pngHeader, art.Data)
+}
+
+func TestVLC_GetArtStream(t *testing.T) {
+	t.Parallel()
+
+	t.Run("art streamed", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			closed bool
+
+			mockClient = &mockStreamClient{
+				getStreamContextFn: func(_ context.Context, endpoint string) (io.ReadCloser, string, error) {
+					require.Equal(t, buildQueryEndpoint(baseArt, paramMap{itemKey: "7"}), endpoint)
+
+					return &closeRecorder{
+						Reader: strings.NewReader("jpeg"),
+						closed: &closed,
+					}, "image/jpeg", nil
+				},
+			}
+		)
+
+		vlc := NewVLC(mockClient)
+
+		body, contentType, err := vlc.GetArtStream(7)
+		require.NoError(t, err)
+
+		data, err := io.ReadAll(body)
+		require.NoError(t, err)
+		require.NoError(t, body.Close())
+
+		assert.Equal(t, []byte("jpeg"), data)
+		assert.Equal(t, "image/jpeg", contentType)
+		assert.True(t, closed)
+	})
+
+	t.Run("current item has no art", func(t *testing.T) {
+		t.Parallel()
+
+		mockClient := &mockStreamClient{
+			getStreamContextFn: func(_ context.Context, endpoint string) (io.ReadCloser, string, error) {
+				require.Equal(t, baseArt, endpoint)
+
+				return nil, "", &client.StatusError{
+					Endpoint: endpoint,
+					Code:     http.StatusNotFound,
+				}
+			},
+		}
+
+		vlc := NewVLC(mockClient)
+
+		body, contentType, err := vlc.GetCurrentArtStream()
+
+		assert.Nil(t, body)
+		assert.Empty(t, contentType)
+		assert.ErrorIs(t, err, ErrNoArt)
+	})
+
+	t.Run("art streamed through middlewares", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			observedEndpoint string
+
+			base = &mockStreamClient{
+				mockClient: mockClient{
+					getFn: func(_ string) ([]byte, error) {
+						t.Fatal("art read into memory")
+
+						return nil, nil
+					},
+				},
+				getStreamContextFn: func(_ context.Context, endpoint string) (io.ReadCloser, string, error) {
+					require.Equal(t, buildQueryEndpoint(baseArt, paramMap{itemKey: "7"}), endpoint)
+
+					return io.NopCloser(strings.NewReader("jpeg")), "image/jpeg", nil
+				},
+			}
+
+			c = client.Chain(
+				base,
+				client.Retry(),
+				client.Timing(func(endpoint string, _ time.Duration, _ error) {
+					observedEndpoint = endpoint
+				}),
+			)
+		)
+
+		vlc := NewVLC(c)
+
+		body, contentType, err := vlc.GetArtStream(7)
+		require.NoError(t, err)
+
+		data, err := io.ReadAll(body)
+		require.NoError(t, err)
+
+		assert.Equal(t, []byte("jpeg"), data)
+		assert.Equal(t, "image/jpeg", contentType)
+		assert.Equal(t, "art?item=7", observedEndpoint)
+	})
+
+	t.Run("art read into memory", func(t *testing.T) {
+		t.Parallel()
+
+		mockClient := &mockClient{
+			getFn: func(_ string) ([]byte, error) {
+				return pngHeader, nil
+			},
+		}
+
+		vlc := NewVLC(mockClient)
+
+		body, contentType, err := vlc.GetArtStream(7)
+		require.NoError(t, err)
+
+		data, err := io.ReadAll(body)
+		require.NoError(t, err)
+
+		assert.Equal(t, pngHeader, data)
+		assert.Equal(t, "image/png", contentType)
+	})
+}
+
+// closeRecorder is a reader that records when it is closed
+type closeRecorder struct {
+	io.Reader
+
+	closed *bool
+}
+
+func (c *closeRecorder) Close() error {
+	*c.closed = true
+
+	return nil
+}
@@ -1,16 +1,17 @@
 package vlc
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/zivkovicmilos/go-vlc/client"
 )
 
 // executeBrowseRequest executes a GET request and parses the response JSON
-func (v *VLC) executeBrowseRequest(params paramMap) (*Browse, error) {
+func (v *VLC) executeBrowseRequest(ctx context.Context, params queryParams) (*Browse, error) {
 	endpoint := buildQueryEndpoint(baseBrowse, params)
 
-	browseRaw, err := v.client.Get(endpoint)
+	browseRaw, err := client.GetWithContext(ctx, v.client, endpoint)
 	if err != nil {
 		return nil, fmt.Errorf("unable to execute request, %s, %w", endpoint, err)
 	}
@@ -23,18 +24,28 @@ func (v *VLC) executeBrowseRequest(params paramMap) (*Browse, error) {
 // Directory URI is the preferred parameter, so consider using BrowseWithURI, since
 // "dir" is deprecated and may be removed in a future release
 func (v *VLC) BrowseWithPath(path string) (*Browse, error) {
+	return v.BrowseWithPathContext(context.Background(), path)
+}
+
+// BrowseWithPathContext is BrowseWithPath with a context that controls the request lifetime
+func (v *VLC) BrowseWithPathContext(ctx context.Context, path string) (*Browse, error) {
 	params := paramMap{
 		dirKey: path,
 	}
 
-	return v.executeBrowseRequest(params)
+	return v.executeBrowseRequest(ctx, params)
 }
 
 // BrowseWithURI browses the given directory URI file list (file://...)
 func (v *VLC) BrowseWithURI(uri string) (*Browse, error) {
+	return v.BrowseWithURIContext(context.Background(), uri)
+}
+
+// BrowseWithURIContext is BrowseWithURI with a context that controls the request lifetime
+func (v *VLC) BrowseWithURIContext(ctx context.Context, uri string) (*Browse, error) {
 	params := paramMap{
 		uriKey: uri,
 	}
 
-	return v.executeBrowseRequest(params)
+	return v.executeBrowseRequest(ctx, params)
 }
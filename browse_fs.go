@@ -0,0 +1,353 @@
+package vlc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"net/url"
+	"path"
+	"slices"
+	"strings"
+	"time"
+)
+
+var (
+	errFileNotReadable = errors.New("file contents can't be read through the browse endpoint")
+	errIsDirectory     = errors.New("is a directory")
+)
+
+// POSIX st_mode bits, as reported in File.Mode
+const (
+	modeTypeMask   = 0o170000
+	modeSocket     = 0o140000
+	modeSymlink    = 0o120000
+	modeBlock      = 0o060000
+	modeDir        = 0o040000
+	modeCharDevice = 0o020000
+	modeNamedPipe  = 0o010000
+	modeSetuid     = 0o4000
+	modeSetgid     = 0o2000
+	modeSticky     = 0o1000
+	modePerm       = 0o777
+)
+
+var (
+	_ fs.FS        = (*BrowseFS)(nil)
+	_ fs.ReadDirFS = (*BrowseFS)(nil)
+	_ fs.StatFS    = (*BrowseFS)(nil)
+)
+
+// FileMode converts the POSIX file mode, as reported by VLC, into a Go file mode
+func (f *File) FileMode() fs.FileMode {
+	mode := fs.FileMode(f.Mode & modePerm)
+
+	switch f.Mode & modeTypeMask {
+	case modeDir:
+		mode |= fs.ModeDir
+	case modeSymlink:
+		mode |= fs.ModeSymlink
+	case modeNamedPipe:
+		mode |= fs.ModeNamedPipe
+	case modeSocket:
+		mode |= fs.ModeSocket
+	case modeCharDevice:
+		mode |= fs.ModeDevice | fs.ModeCharDevice
+	case modeBlock:
+		mode |= fs.ModeDevice
+	}
+
+	if f.Mode&modeSetuid != 0 {
+		mode |= fs.ModeSetuid
+	}
+
+	if f.Mode&modeSetgid != 0 {
+		mode |= fs.ModeSetgid
+	}
+
+	if f.Mode&modeSticky != 0 {
+		mode |= fs.ModeSticky
+	}
+
+	// The file type is authoritative, even if the mode wasn't reported
+	if f.IsDir() {
+		mode |= fs.ModeDir
+	}
+
+	return mode
+}
+
+// ModTime returns the modification time of the file
+func (f *File) ModTime() time.Time {
+	return time.Unix(int64(f.ModificationTime), 0)
+}
+
+// Info returns the file as an fs.FileInfo (and fs.DirEntry).
+// The underlying *File is returned from Sys
+func (f *File) Info() fs.FileInfo {
+	return &fileInfo{file: f, name: f.Name}
+}
+
+// fileInfo is a browsed file, as a fs.FileInfo and fs.DirEntry
+type fileInfo struct {
+	file *File
+	name string
+}
+
+// Name returns the base name of the file
+func (i *fileInfo) Name() string {
+	return i.name
+}
+
+// Size returns the size of the file, in bytes
+func (i *fileInfo) Size() int64 {
+	return int64(i.file.Size)
+}
+
+// Mode returns the file mode
+func (i *fileInfo) Mode() fs.FileMode {
+	return i.file.FileMode()
+}
+
+// ModTime returns the modification time
+func (i *fileInfo) ModTime() time.Time {
+	return i.file.ModTime()
+}
+
+// IsDir checks if the file is a directory
+func (i *fileInfo) IsDir() bool {
+	return i.file.IsDir()
+}
+
+// Sys returns the underlying *File
+func (i *fileInfo) Sys() any {
+	return i.file
+}
+
+// Type returns the file type bits
+func (i *fileInfo) Type() fs.FileMode {
+	return i.Mode().Type()
+}
+
+// Info returns the file information, for fs.DirEntry
+func (i *fileInfo) Info() (fs.FileInfo, error) {
+	return i, nil
+}
+
+// String returns the string representation of the file information
+func (i *fileInfo) String() string {
+	return fs.FormatFileInfo(i)
+}
+
+// BrowseFS is a read-only view of the remote VLC host filesystem, over the browse endpoint.
+// Directories can be listed and stat'd, but file contents can't be read.
+//
+// The root directory (".") has no stat information, as VLC only reports it for directory entries.
+// VLC follows symlinks, and reports them as their targets, so fs.WalkDir can loop (Walk detects loops)
+type BrowseFS struct {
+	ctx     context.Context //nolint:containedctx // fs.FS methods don't take a context
+	vlc     *VLC
+	rootURI string
+}
+
+// NewBrowseFS creates a filesystem rooted at the given directory URI (file:///...)
+func NewBrowseFS(vlc *VLC, rootURI string) *BrowseFS {
+	return &BrowseFS{
+		ctx:     context.Background(),
+		vlc:     vlc,
+		rootURI: strings.TrimSuffix(rootURI, "/"),
+	}
+}
+
+// WithContext returns a copy of the filesystem, which uses the context for its requests
+func (f *BrowseFS) WithContext(ctx context.Context) *BrowseFS {
+	copied := *f
+	copied.ctx = ctx
+
+	return &copied
+}
+
+// uri returns the directory URI for the valid fs path
+func (f *BrowseFS) uri(name string) string {
+	if name == "." {
+		return f.rootURI
+	}
+
+	segments := strings.Split(name, "/")
+
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+
+	return f.rootURI + "/" + strings.Join(segments, "/")
+}
+
+// list returns the sorted entries of the directory at the valid path, without the ".." entry
+func (f *BrowseFS) list(name string) ([]File, error) {
+	listing, err := f.vlc.BrowseWithURIContext(f.ctx, f.uri(name))
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]File, 0, len(listing.Elements))
+
+	for _, element := range listing.Elements {
+		if element.Name == parentDirName || element.Name == currentDirName {
+			continue
+		}
+
+		entries = append(entries, element)
+	}
+
+	slices.SortFunc(entries, func(a, b File) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return entries, nil
+}
+
+// Stat returns the file information, by listing its parent directory
+func (f *BrowseFS) Stat(name string) (fs.FileInfo, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "stat", Path: name, Err: fs.ErrInvalid}
+	}
+
+	if name == "." {
+		return &fileInfo{file: &File{Type: fileTypeDir, URI: f.rootURI}, name: "."}, nil
+	}
+
+	entries, err := f.list(path.Dir(name))
+	if err != nil {
+		return nil, &fs.PathError{Op: "stat", Path: name, Err: err}
+	}
+
+	base := path.Base(name)
+
+	for i := range entries {
+		if entries[i].Name == base {
+			return entries[i].Info(), nil
+		}
+	}
+
+	return nil, &fs.PathError{Op: "stat", Path: name, Err: fs.ErrNotExist}
+}
+
+// ReadDir lists the directory, sorted by file name
+func (f *BrowseFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: fs.ErrInvalid}
+	}
+
+	entries, err := f.list(name)
+	if err != nil {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: err}
+	}
+
+	dirEntries := make([]fs.DirEntry, 0, len(entries))
+
+	for i := range entries {
+		dirEntries = append(dirEntries, &fileInfo{file: &entries[i], name: entries[i].Name})
+	}
+
+	return dirEntries, nil
+}
+
+// Open opens the named file or directory. Directories implement fs.ReadDirFile,
+// and reading files returns an error, as their contents aren't available
+func (f *BrowseFS) Open(name string) (fs.File, error) {
+	info, err := f.Stat(name)
+	if err != nil {
+		var pathErr *fs.PathError
+		if errors.As(err, &pathErr) {
+			pathErr.Op = "open"
+		}
+
+		return nil, err
+	}
+
+	return &browseFile{
+		fs:   f,
+		info: info,
+		name: name,
+	}, nil
+}
+
+// browseFile is an opened BrowseFS file or directory
+type browseFile struct {
+	fs      *BrowseFS
+	info    fs.FileInfo
+	name    string
+	entries []fs.DirEntry // remaining directory entries, listed on the first ReadDir
+	listed  bool
+	closed  bool
+}
+
+// Stat returns the file information
+func (b *browseFile) Stat() (fs.FileInfo, error) {
+	if b.closed {
+		return nil, &fs.PathError{Op: "stat", Path: b.name, Err: fs.ErrClosed}
+	}
+
+	return b.info, nil
+}
+
+// Read always fails, as the file contents aren't available through the browse endpoint
+func (b *browseFile) Read([]byte) (int, error) {
+	switch {
+	case b.closed:
+		return 0, &fs.PathError{Op: "read", Path: b.name, Err: fs.ErrClosed}
+	case b.info.IsDir():
+		return 0, &fs.PathError{Op: "read", Path: b.name, Err: errIsDirectory}
+	default:
+		return 0, &fs.PathError{Op: "read", Path: b.name, Err: errFileNotReadable}
+	}
+}
+
+// Close closes the file
+func (b *browseFile) Close() error {
+	if b.closed {
+		return &fs.PathError{Op: "close", Path: b.name, Err: fs.ErrClosed}
+	}
+
+	b.closed = true
+
+	return nil
+}
+
+// ReadDir returns the next n directory entries, or all the remaining entries if n <= 0
+func (b *browseFile) ReadDir(n int) ([]fs.DirEntry, error) {
+	if b.closed {
+		return nil, &fs.PathError{Op: "readdir", Path: b.name, Err: fs.ErrClosed}
+	}
+
+	if !b.info.IsDir() {
+		return nil, &fs.PathError{Op: "readdir", Path: b.name, Err: fs.ErrInvalid}
+	}
+
+	if !b.listed {
+		entries, err := b.fs.ReadDir(b.name)
+		if err != nil {
+			return nil, err
+		}
+
+		b.entries, b.listed = entries, true
+	}
+
+	if n <= 0 {
+		entries := b.entries
+		b.entries = nil
+
+		return entries, nil
+	}
+
+	if len(b.entries) == 0 {
+		return nil, io.EOF
+	}
+
+	n = min(n, len(b.entries))
+
+	entries := b.entries[:n]
+	b.entries = b.entries[n:]
+
+	return entries, nil
+}
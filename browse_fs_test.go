@@ -0,0 +1,198 @@
+package vlc
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFile_FileMode(t *testing.T) {
+	t.Parallel()
+
+	testTable := []struct {
+		name         string
+		file         File
+		expectedMode fs.FileMode
+	}{
+		{"regular file", File{Type: fileTypeFile, Mode: 0o100644}, 0o644},
+		{"directory", File{Type: fileTypeDir, Mode: 0o40755}, fs.ModeDir | 0o755},
+		{"directory without mode", File{Type: fileTypeDir}, fs.ModeDir},
+		{"symlink", File{Mode: 0o120777}, fs.ModeSymlink | 0o777},
+		{"character device", File{Mode: 0o20666}, fs.ModeDevice | fs.ModeCharDevice | 0o666},
+		{"block device", File{Mode: 0o60660}, fs.ModeDevice | 0o660},
+		{"named pipe", File{Mode: 0o10644}, fs.ModeNamedPipe | 0o644},
+		{"socket", File{Mode: 0o140755}, fs.ModeSocket | 0o755},
+		{"special bits", File{Type: fileTypeFile, Mode: 0o107755}, fs.ModeSetuid | fs.ModeSetgid | fs.ModeSticky | 0o755},
+	}
+
+	for _, testCase := range testTable {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, testCase.expectedMode, testCase.file.FileMode())
+		})
+	}
+}
+
+func TestBrowseFS(t *testing.T) {
+	t.Parallel()
+
+	newFS := func(t *testing.T) *BrowseFS {
+		t.Helper()
+
+		return NewBrowseFS(NewVLC(newTestFS(t)), "file:///media/")
+	}
+
+	t.Run("stat", func(t *testing.T) {
+		t.Parallel()
+
+		info, err := newFS(t).Stat("movies")
+		require.NoError(t, err)
+
+		assert.Equal(t, "movies", info.Name())
+		assert.True(t, info.IsDir())
+		assert.Equal(t, fs.ModeDir|0o755, info.Mode())
+		assert.Equal(t, int64(4096), info.Size())
+		assert.Equal(t, time.Unix(100, 0), info.ModTime())
+		assert.Equal(t, "file:///media/movies", info.Sys().(*File).URI)
+
+		info, err = newFS(t).Stat(".")
+		require.NoError(t, err)
+
+		assert.True(t, info.IsDir())
+	})
+
+	t.Run("stat errors", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := newFS(t).Stat("movies/missing.mkv")
+		assert.ErrorIs(t, err, fs.ErrNotExist)
+
+		_, err = newFS(t).Stat("/media/movies")
+		assert.ErrorIs(t, err, fs.ErrInvalid)
+
+		_, err = newFS(t).Stat("locked/file.mp4")
+
+		var pathErr *fs.PathError
+
+		require.ErrorAs(t, err, &pathErr)
+		assert.Equal(t, "locked/file.mp4", pathErr.Path)
+		assert.ErrorIs(t, err, errTestListing)
+	})
+
+	t.Run("read dir", func(t *testing.T) {
+		t.Parallel()
+
+		entries, err := fs.ReadDir(newFS(t), "movies")
+		require.NoError(t, err)
+
+		names := make([]string, 0, len(entries))
+
+		for _, entry := range entries {
+			names = append(names, entry.Name())
+		}
+
+		// Sorted, without the ".." entry
+		assert.Equal(t, []string{"b.mkv", "back", "deep"}, names)
+		assert.False(t, entries[0].IsDir())
+		assert.Equal(t, fs.ModeDir, entries[1].Type())
+	})
+
+	t.Run("open directory", func(t *testing.T) {
+		t.Parallel()
+
+		file, err := newFS(t).Open("movies")
+		require.NoError(t, err)
+
+		dir, ok := file.(fs.ReadDirFile)
+		require.True(t, ok)
+
+		entries, err := dir.ReadDir(2)
+		require.NoError(t, err)
+		assert.Len(t, entries, 2)
+
+		entries, err = dir.ReadDir(2)
+		require.NoError(t, err)
+		assert.Len(t, entries, 1)
+
+		_, err = dir.ReadDir(2)
+		assert.ErrorIs(t, err, io.EOF)
+
+		_, err = file.Read(make([]byte, 1))
+		assert.ErrorIs(t, err, errIsDirectory)
+
+		require.NoError(t, file.Close())
+		assert.ErrorIs(t, file.Close(), fs.ErrClosed)
+	})
+
+	t.Run("open file", func(t *testing.T) {
+		t.Parallel()
+
+		file, err := newFS(t).Open("a.mp3")
+		require.NoError(t, err)
+
+		info, err := file.Stat()
+		require.NoError(t, err)
+		assert.Equal(t, "a.mp3", info.Name())
+
+		_, err = file.Read(make([]byte, 1))
+		assert.ErrorIs(t, err, errFileNotReadable)
+
+		_, err = newFS(t).Open("missing.mp3")
+		assert.ErrorIs(t, err, fs.ErrNotExist)
+	})
+
+	t.Run("glob", func(t *testing.T) {
+		t.Parallel()
+
+		matches, err := fs.Glob(newFS(t), "movies/*/*.mp4")
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"movies/deep/c.mp4"}, matches)
+	})
+
+	t.Run("walk dir", func(t *testing.T) {
+		t.Parallel()
+
+		paths := make([]string, 0)
+
+		err := fs.WalkDir(newFS(t), ".", func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				// The locked directory
+				if errors.Is(err, errTestListing) {
+					return nil
+				}
+
+				return err
+			}
+
+			// fs.WalkDir has no loop detection, and VLC reports symlinks as directories
+			if d.Name() == "back" {
+				return fs.SkipDir
+			}
+
+			paths = append(paths, path)
+
+			return nil
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{
+			".",
+			"a.mp3",
+			"locked",
+			"movies",
+			"movies/b.mkv",
+			"movies/deep",
+			"movies/deep/c.mp4",
+			"notes.txt",
+		}, paths)
+	})
+}
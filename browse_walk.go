@@ -0,0 +1,403 @@
+package vlc
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+)
+
+// ErrDirectoryLoop is passed to the BrowseWalkFunc for a directory
+// that is the same as one of its parents, reached through a symlink
+var ErrDirectoryLoop = errors.New("directory loop")
+
+const (
+	fileTypeDir  = "dir"
+	fileTypeFile = "file"
+
+	parentDirName  = ".."
+	currentDirName = "."
+
+	defaultWalkWorkers = 4
+)
+
+// IsDir checks if the browsed file is a directory
+func (f *File) IsDir() bool {
+	return f.Type == fileTypeDir
+}
+
+// BrowseWalkFunc is called for every file and directory found by Walk, with its depth
+// (entries of the root directory are at depth 1).
+//
+// If a directory can't be listed, or is a loop (ErrDirectoryLoop), the function is called
+// a second time for the directory, with the error. Returning ErrSkipChildren for a directory
+// skips its contents, and any other error stops the walk
+type BrowseWalkFunc func(file *File, depth int, err error) error
+
+// WalkOption is a functional option for Walk
+type WalkOption func(*walkConfig)
+
+// walkConfig holds the walk configuration
+type walkConfig struct {
+	extensions map[string]struct{}
+	mediaTypes []MediaType
+	maxDepth   int
+	workers    int
+}
+
+// newWalkConfig creates the walk configuration, with the given options applied
+func newWalkConfig(opts []WalkOption) *walkConfig {
+	cfg := &walkConfig{
+		extensions: make(map[string]struct{}),
+		mediaTypes: make([]MediaType, 0),
+		workers:    defaultWalkWorkers,
+	}
+
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	if cfg.workers < 1 {
+		cfg.workers = 1
+	}
+
+	return cfg
+}
+
+// WithMaxDepth limits the depth of the walk. A max depth of 1 lists only the root directory,
+// and a max depth of 0 (default) is unlimited
+func WithMaxDepth(depth int) WalkOption {
+	return func(c *walkConfig) {
+		c.maxDepth = depth
+	}
+}
+
+// WithWalkWorkers sets the number of directories that are listed concurrently
+func WithWalkWorkers(workers int) WalkOption {
+	return func(c *walkConfig) {
+		c.workers = workers
+	}
+}
+
+// WithExtensions only reports files with the given extensions (case-insensitive, such as ".mp4" or "mkv").
+// Directories are always reported
+func WithExtensions(extensions ...string) WalkOption {
+	return func(c *walkConfig) {
+		for ext := range newExtensionSet(extensions...) {
+			c.extensions[ext] = struct{}{}
+		}
+	}
+}
+
+// WithMediaTypes only reports files of the given media types.
+// Combined with WithExtensions, files matching either filter are reported
+func WithMediaTypes(mediaTypes ...MediaType) WalkOption {
+	return func(c *walkConfig) {
+		c.mediaTypes = append(c.mediaTypes, mediaTypes...)
+	}
+}
+
+// matches checks if the file passes the configured filters
+func (c *walkConfig) matches(file *File) bool {
+	if len(c.extensions) == 0 && len(c.mediaTypes) == 0 {
+		return true
+	}
+
+	if _, ok := c.extensions[extension(file.Name)]; ok {
+		return true
+	}
+
+	for _, mediaType := range c.mediaTypes {
+		if mediaType.Matches(file.Name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// directoryIdentity identifies a listed directory, for loop detection.
+// VLC reports the stat of the symlink target, without the inode,
+// so a directory is identified by its stat and its entry names.
+//
+// The root directory stat isn't known, so a loop back to the root
+// is detected one level deeper, at its second occurrence
+type directoryIdentity struct {
+	entries          string
+	modificationTime uint64
+	creationTime     uint64
+	mode             uint64
+	uid              uint64
+	gid              uint64
+	size             uint64
+}
+
+// newDirectoryIdentity creates the identity of the listed directory
+func newDirectoryIdentity(dir *File, listing *Browse) directoryIdentity {
+	names := make([]string, 0, len(listing.Elements))
+
+	for _, element := range listing.Elements {
+		names = append(names, element.Name)
+	}
+
+	slices.Sort(names)
+
+	return directoryIdentity{
+		// Names can't contain a NUL byte
+		entries:          joinNames(names),
+		modificationTime: dir.ModificationTime,
+		creationTime:     dir.CreationTime,
+		mode:             dir.Mode,
+		uid:              dir.UID,
+		gid:              dir.GID,
+		size:             dir.Size,
+	}
+}
+
+// joinNames joins the names with a NUL separator
+func joinNames(names []string) string {
+	size := 0
+	for _, name := range names {
+		size += len(name) + 1
+	}
+
+	joined := make([]byte, 0, size)
+
+	for _, name := range names {
+		joined = append(joined, name...)
+		joined = append(joined, 0)
+	}
+
+	return string(joined)
+}
+
+// walkJob is a directory waiting to be listed
+type walkJob struct {
+	dir       *File
+	ancestors []directoryIdentity
+	depth     int
+}
+
+// walker holds the state of a single concurrent walk
+type walker struct {
+	err     error
+	vlc     *VLC
+	cfg     *walkConfig
+	fn      BrowseWalkFunc
+	cancel  context.CancelFunc
+	queue   []walkJob
+	pending int // the queued directories, and the ones being listed
+	queueMu sync.Mutex
+	ready   *sync.Cond // signaled when a directory is queued, or the walk is done
+	mu      sync.Mutex // serializes the walk function calls, and guards err
+}
+
+// Walk recursively walks the remote directory tree, starting from the root directory URI.
+// Directories are listed concurrently by a fixed number of workers (see WithWalkWorkers),
+// but the walk function is never called concurrently. The order of the calls is
+// not deterministic, apart from a directory being reported before its contents.
+//
+// The ".." entries are skipped, and directory loops (through symlinks) are detected and not followed
+func (v *VLC) Walk(rootURI string, fn BrowseWalkFunc, opts ...WalkOption) error {
+	return v.WalkContext(context.Background(), rootURI, fn, opts...)
+}
+
+// WalkContext is Walk with a context that controls the walk lifetime
+func (v *VLC) WalkContext(ctx context.Context, rootURI string, fn BrowseWalkFunc, opts ...WalkOption) error {
+	cfg := newWalkConfig(opts)
+
+	// The root listing errors are returned directly
+	root, err := v.BrowseWithURIContext(ctx, rootURI)
+	if err != nil {
+		return err
+	}
+
+	walkCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	w := &walker{
+		vlc:    v,
+		cfg:    cfg,
+		fn:     fn,
+		queue:  make([]walkJob, 0),
+		cancel: cancel,
+	}
+
+	w.ready = sync.NewCond(&w.queueMu)
+
+	// Waiting workers are woken up when the walk is stopped
+	stop := context.AfterFunc(walkCtx, func() {
+		w.queueMu.Lock()
+		defer w.queueMu.Unlock()
+
+		w.ready.Broadcast()
+	})
+	defer stop()
+
+	w.visit(walkCtx, root, 1, make([]directoryIdentity, 0))
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < cfg.workers; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			w.work(walkCtx)
+		}()
+	}
+
+	wg.Wait()
+
+	if w.err != nil {
+		return w.err
+	}
+
+	return ctx.Err()
+}
+
+// call calls the walk function, unless the walk was stopped.
+// It returns false if the walk should not continue into the file
+func (w *walker) call(file *File, depth int, err error) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.err != nil {
+		return false
+	}
+
+	fnErr := w.fn(file, depth, err)
+
+	switch {
+	case fnErr == nil:
+		return true
+	case errors.Is(fnErr, ErrSkipChildren):
+		return false
+	default:
+		w.err = fnErr
+		w.cancel()
+
+		return false
+	}
+}
+
+// push queues the directory for listing
+func (w *walker) push(job walkJob) {
+	w.queueMu.Lock()
+	defer w.queueMu.Unlock()
+
+	w.queue = append(w.queue, job)
+	w.pending++
+
+	w.ready.Signal()
+}
+
+// next waits for a queued directory. The most recently queued directory is taken first,
+// so the queue grows with the depth of the tree instead of its width.
+// It returns false once all directories are listed, or the walk is stopped
+func (w *walker) next(ctx context.Context) (walkJob, bool) {
+	w.queueMu.Lock()
+	defer w.queueMu.Unlock()
+
+	for len(w.queue) == 0 && w.pending > 0 && ctx.Err() == nil {
+		w.ready.Wait()
+	}
+
+	if len(w.queue) == 0 || ctx.Err() != nil {
+		return walkJob{}, false
+	}
+
+	job := w.queue[len(w.queue)-1]
+	w.queue = w.queue[:len(w.queue)-1]
+
+	return job, true
+}
+
+// done marks a taken directory as listed
+func (w *walker) done() {
+	w.queueMu.Lock()
+	defer w.queueMu.Unlock()
+
+	w.pending--
+
+	if w.pending == 0 {
+		// Wake up the idle workers, so they can exit
+		w.ready.Broadcast()
+	}
+}
+
+// work lists the queued directories, until the walk is done
+func (w *walker) work(ctx context.Context) {
+	for {
+		job, ok := w.next(ctx)
+		if !ok {
+			return
+		}
+
+		w.list(ctx, job)
+		w.done()
+	}
+}
+
+// visit reports the entries of the listed directory, at the given depth,
+// and queues the subdirectories for listing
+func (w *walker) visit(ctx context.Context, listing *Browse, depth int, ancestors []directoryIdentity) {
+	for i := range listing.Elements {
+		if ctx.Err() != nil {
+			return
+		}
+
+		entry := &listing.Elements[i]
+
+		if entry.Name == parentDirName || entry.Name == currentDirName {
+			continue
+		}
+
+		if !entry.IsDir() {
+			if w.cfg.matches(entry) {
+				w.call(entry, depth, nil)
+			}
+
+			continue
+		}
+
+		if !w.call(entry, depth, nil) {
+			continue
+		}
+
+		if w.cfg.maxDepth > 0 && depth >= w.cfg.maxDepth {
+			continue
+		}
+
+		w.push(walkJob{
+			dir:       entry,
+			depth:     depth,
+			ancestors: ancestors,
+		})
+	}
+}
+
+// list lists the queued directory, and visits its entries
+func (w *walker) list(ctx context.Context, job walkJob) {
+	listing, err := w.vlc.BrowseWithURIContext(ctx, job.dir.URI)
+	if err != nil {
+		// Errors caused by the walk being stopped aren't reported
+		if ctx.Err() == nil {
+			w.call(job.dir, job.depth, err)
+		}
+
+		return
+	}
+
+	identity := newDirectoryIdentity(job.dir, listing)
+
+	if slices.Contains(job.ancestors, identity) {
+		w.call(job.dir, job.depth, ErrDirectoryLoop)
+
+		return
+	}
+
+	// The ancestors are shared between siblings, so they are copied before appending
+	w.visit(ctx, listing, job.depth+1, append(slices.Clone(job.ancestors), identity))
+}
@@ -0,0 +1,337 @@
+package vlc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"sort"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errTestListing = errors.New("permission denied")
+
+// newTestFS creates a mock client serving browse listings of a fake remote filesystem:
+//
+//	/media
+//	├── a.mp3
+//	├── notes.txt
+//	├── locked/    (can't be listed)
+//	└── movies/
+//	    ├── b.mkv
+//	    ├── back/  (symlink to /media/movies)
+//	    └── deep/
+//	        └── c.mp4
+func newTestFS(t *testing.T) *mockClient {
+	t.Helper()
+
+	var (
+		moviesDir = File{Type: fileTypeDir, ModificationTime: 100, Mode: 0o40755, Size: 4096}
+
+		dir = func(stat File, uri, name string) File {
+			stat.Type = fileTypeDir
+			stat.URI = uri
+			stat.Name = name
+
+			return stat
+		}
+
+		file = func(uri, name string) File {
+			return File{Type: fileTypeFile, URI: uri, Name: name}
+		}
+
+		parent = dir(File{}, "", parentDirName)
+
+		moviesListing = func(base string) []File {
+			return []File{
+				parent,
+				file(base+"/b.mkv", "b.mkv"),
+				dir(moviesDir, base+"/back", "back"),
+				dir(File{ModificationTime: 200}, base+"/deep", "deep"),
+			}
+		}
+
+		listings = map[string][]File{
+			"file:///media": {
+				parent,
+				file("file:///media/a.mp3", "a.mp3"),
+				file("file:///media/notes.txt", "notes.txt"),
+				dir(File{}, "file:///media/locked", "locked"),
+				dir(moviesDir, "file:///media/movies", "movies"),
+			},
+			"file:///media/movies":      moviesListing("file:///media/movies"),
+			"file:///media/movies/back": moviesListing("file:///media/movies/back"),
+			"file:///media/movies/deep": {parent, file("file:///media/movies/deep/c.mp4", "c.mp4")},
+		}
+	)
+
+	return &mockClient{
+		getContextFn: func(ctx context.Context, endpoint string) ([]byte, error) {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+
+			// Called from the walk workers, so failures can't stop the test
+			parsed, err := url.Parse(endpoint)
+			if !assert.NoError(t, err) {
+				return nil, err
+			}
+
+			listing, ok := listings[parsed.Query().Get(uriKey)]
+			if !ok {
+				return nil, errTestListing
+			}
+
+			return json.Marshal(&Browse{Elements: listing})
+		},
+	}
+}
+
+// walkEntry is a single walk function call
+type walkEntry struct {
+	err   error
+	uri   string
+	depth int
+}
+
+// collectWalk walks the fake filesystem, and returns the sorted walk function calls
+func collectWalk(t *testing.T, opts ...WalkOption) ([]walkEntry, error) {
+	t.Helper()
+
+	var (
+		entries = make([]walkEntry, 0)
+		active  atomic.Int32
+	)
+
+	vlc := NewVLC(newTestFS(t))
+
+	err := vlc.Walk("file:///media", func(file *File, depth int, err error) error {
+		// The walk function is never called concurrently
+		assert.Equal(t, int32(1), active.Add(1))
+		defer active.Add(-1)
+
+		// Errors are compared by their sentinel
+		for _, sentinel := range []error{errTestListing, ErrDirectoryLoop} {
+			if errors.Is(err, sentinel) {
+				err = sentinel
+			}
+		}
+
+		entries = append(entries, walkEntry{uri: file.URI, depth: depth, err: err})
+
+		return nil
+	}, opts...)
+
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].uri == entries[j].uri {
+			return entries[i].err == nil
+		}
+
+		return entries[i].uri < entries[j].uri
+	})
+
+	return entries, err
+}
+
+func TestVLC_Walk(t *testing.T) {
+	t.Parallel()
+
+	t.Run("full walk", func(t *testing.T) {
+		t.Parallel()
+
+		entries, err := collectWalk(t, WithWalkWorkers(2))
+		require.NoError(t, err)
+
+		// The listing error, and the loop, are reported with a second call for the directory
+		assert.Equal(t, []walkEntry{
+			{uri: "file:///media/a.mp3", depth: 1},
+			{uri: "file:///media/locked", depth: 1},
+			{uri: "file:///media/locked", depth: 1, err: errTestListing},
+			{uri: "file:///media/movies", depth: 1},
+			{uri: "file:///media/movies/b.mkv", depth: 2},
+			{uri: "file:///media/movies/back", depth: 2},
+			{uri: "file:///media/movies/back", depth: 2, err: ErrDirectoryLoop},
+			{uri: "file:///media/movies/deep", depth: 2},
+			{uri: "file:///media/movies/deep/c.mp4", depth: 3},
+			{uri: "file:///media/notes.txt", depth: 1},
+		}, entries)
+	})
+
+	t.Run("max depth", func(t *testing.T) {
+		t.Parallel()
+
+		entries, err := collectWalk(t, WithMaxDepth(1))
+		require.NoError(t, err)
+
+		assert.Equal(t, []walkEntry{
+			{uri: "file:///media/a.mp3", depth: 1},
+			{uri: "file:///media/locked", depth: 1},
+			{uri: "file:///media/movies", depth: 1},
+			{uri: "file:///media/notes.txt", depth: 1},
+		}, entries)
+	})
+
+	t.Run("filters", func(t *testing.T) {
+		t.Parallel()
+
+		entries, err := collectWalk(t, WithMediaTypes(MediaVideo), WithExtensions("TXT"))
+		require.NoError(t, err)
+
+		files := make([]string, 0)
+
+		for _, entry := range entries {
+			if extension(entry.uri) != "" {
+				files = append(files, entry.uri)
+			}
+		}
+
+		assert.Equal(t, []string{
+			"file:///media/movies/b.mkv",
+			"file:///media/movies/deep/c.mp4",
+			"file:///media/notes.txt",
+		}, files)
+	})
+
+	t.Run("directory skipped", func(t *testing.T) {
+		t.Parallel()
+
+		var count atomic.Int32
+
+		vlc := NewVLC(newTestFS(t))
+
+		err := vlc.Walk("file:///media", func(file *File, _ int, _ error) error {
+			count.Add(1)
+
+			if file.IsDir() {
+				return ErrSkipChildren
+			}
+
+			return nil
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, int32(4), count.Load())
+	})
+
+	t.Run("walk stopped", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			stopErr = errors.New("stop")
+			calls   atomic.Int32
+		)
+
+		vlc := NewVLC(newTestFS(t))
+
+		err := vlc.Walk("file:///media", func(file *File, _ int, _ error) error {
+			calls.Add(1)
+
+			if file.Name == "movies" {
+				return stopErr
+			}
+
+			return nil
+		})
+
+		assert.ErrorIs(t, err, stopErr)
+		assert.LessOrEqual(t, calls.Load(), int32(4))
+	})
+
+	t.Run("root listing error", func(t *testing.T) {
+		t.Parallel()
+
+		vlc := NewVLC(newTestFS(t))
+
+		err := vlc.Walk("file:///missing", func(*File, int, error) error {
+			t.Error("walk function called")
+
+			return nil
+		})
+
+		assert.ErrorIs(t, err, errTestListing)
+	})
+
+	t.Run("context canceled", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		vlc := NewVLC(newTestFS(t))
+
+		err := vlc.WalkContext(ctx, "file:///media", func(*File, int, error) error {
+			cancel()
+
+			return nil
+		})
+
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("listings bounded by the workers", func(t *testing.T) {
+		t.Parallel()
+
+		const (
+			workers = 2
+			dirs    = 50
+		)
+
+		var (
+			active    atomic.Int32
+			maxActive atomic.Int32
+			listed    atomic.Int32
+		)
+
+		mockClient := &mockClient{
+			getContextFn: func(_ context.Context, endpoint string) ([]byte, error) {
+				current := active.Add(1)
+				defer active.Add(-1)
+
+				for {
+					observed := maxActive.Load()
+					if current <= observed || maxActive.CompareAndSwap(observed, current) {
+						break
+					}
+				}
+
+				listed.Add(1)
+
+				parsed, err := url.Parse(endpoint)
+				if !assert.NoError(t, err) {
+					return nil, err
+				}
+
+				// The root holds many empty directories
+				if parsed.Query().Get(uriKey) != "file:///wide" {
+					return json.Marshal(&Browse{Elements: []File{}})
+				}
+
+				elements := make([]File, 0, dirs)
+
+				for i := 0; i < dirs; i++ {
+					name := strconv.Itoa(i)
+
+					elements = append(elements, File{
+						Type: fileTypeDir,
+						URI:  "file:///wide/" + name,
+						Name: name,
+					})
+				}
+
+				return json.Marshal(&Browse{Elements: elements})
+			},
+		}
+
+		err := NewVLC(mockClient).Walk("file:///wide", func(*File, int, error) error {
+			return nil
+		}, WithWalkWorkers(workers))
+		require.NoError(t, err)
+
+		assert.Equal(t, int32(dirs+1), listed.Load())
+		assert.LessOrEqual(t, maxActive.Load(), int32(workers))
+	})
+}
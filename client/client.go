@@ -1,7 +1,64 @@
 package client
 
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+)
+
 // Client is the remote VLC web server client abstraction
 type Client interface {
 	// Get executes a GET request, and returns the response body
 	Get(endpoint string) ([]byte, error)
 }
+
+// ContextClient is a Client that can bind requests to a context.
+// Clients are not required to implement it, callers should fall back to Client when unavailable
+type ContextClient interface {
+	Client
+
+	// GetContext executes a GET request bound to the given context, and returns the response body.
+	// Cancelling the context, or exceeding its deadline, aborts the request
+	GetContext(ctx context.Context, endpoint string) ([]byte, error)
+}
+
+// GetWithContext executes a GET request bound to the given context, if the client is a ContextClient.
+// Otherwise, the context is only checked before the request is executed with Get
+func GetWithContext(ctx context.Context, client Client, endpoint string) ([]byte, error) {
+	if contextClient, ok := client.(ContextClient); ok {
+		return contextClient.GetContext(ctx, endpoint)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return client.Get(endpoint)
+}
+
+// StreamClient is a Client that can also stream the response body, instead of reading it into memory.
+// Clients are not required to implement it, callers should fall back to Client when unavailable
+type StreamClient interface {
+	Client
+
+	// GetStreamContext executes a GET request bound to the given context, and returns the response
+	// body stream, along with its content type. The caller is responsible for closing the stream
+	GetStreamContext(ctx context.Context, endpoint string) (io.ReadCloser, string, error)
+}
+
+// Stream executes a GET request, and returns the response body stream along with its content type.
+// The body is streamed if the client is a StreamClient. Otherwise, it is read into memory,
+// and the content type is detected from it
+func Stream(ctx context.Context, client Client, endpoint string) (io.ReadCloser, string, error) {
+	if streamClient, ok := client.(StreamClient); ok {
+		return streamClient.GetStreamContext(ctx, endpoint)
+	}
+
+	body, err := GetWithContext(ctx, client, endpoint)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return io.NopCloser(bytes.NewReader(body)), http.DetectContentType(body), nil
+}
@@ -0,0 +1,76 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// getClient is a client without context support
+type getClient struct {
+	calls int
+}
+
+func (c *getClient) Get(_ string) ([]byte, error) {
+	c.calls++
+
+	return []byte("response"), nil
+}
+
+func TestGetWithContext(t *testing.T) {
+	t.Parallel()
+
+	t.Run("context client", func(t *testing.T) {
+		t.Parallel()
+
+		type ctxKey struct{}
+
+		var (
+			ctx = context.WithValue(context.Background(), ctxKey{}, "value")
+
+			c = &mockClient{
+				getContextFn: func(ctx context.Context, _ string) ([]byte, error) {
+					assert.Equal(t, "value", ctx.Value(ctxKey{}))
+
+					return []byte("response"), nil
+				},
+			}
+		)
+
+		response, err := GetWithContext(ctx, c, "requests/status.json")
+		require.NoError(t, err)
+
+		assert.Equal(t, []byte("response"), response)
+	})
+
+	t.Run("fallback to Get", func(t *testing.T) {
+		t.Parallel()
+
+		c := &getClient{}
+
+		response, err := GetWithContext(context.Background(), c, "requests/status.json")
+		require.NoError(t, err)
+
+		assert.Equal(t, []byte("response"), response)
+		assert.Equal(t, 1, c.calls)
+	})
+
+	t.Run("fallback with a done context", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			c           = &getClient{}
+			ctx, cancel = context.WithCancel(context.Background())
+		)
+
+		cancel()
+
+		response, err := GetWithContext(ctx, c, "requests/status.json")
+
+		assert.Nil(t, response)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, 0, c.calls)
+	})
+}
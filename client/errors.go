@@ -0,0 +1,55 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+var (
+	// ErrUnauthorized is returned when the VLC web server rejects the credentials
+	// (for example, a wrong --http-password)
+	ErrUnauthorized = errors.New("unauthorized")
+
+	// ErrUnreachable is returned when the VLC web server cannot be reached
+	ErrUnreachable = errors.New("server unreachable")
+)
+
+// StatusError is returned when the VLC web server responds with a non-OK status code
+type StatusError struct {
+	Endpoint string // the requested endpoint
+	Body     string // the (truncated) response body
+	Code     int    // the response status code
+}
+
+// Error returns the string representation of the status error
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("invalid status code, %d, %s", e.Code, e.Endpoint)
+}
+
+// Unwrap returns ErrUnauthorized for 401 responses, so the
+// status error matches it with errors.Is
+func (e *StatusError) Unwrap() error {
+	if e.Code == http.StatusUnauthorized {
+		return ErrUnauthorized
+	}
+
+	return nil
+}
+
+// DecodeError is returned when the response body cannot be decoded
+type DecodeError struct {
+	Err    error  // the underlying decoding error
+	Format string // the expected format [JSON, XML]
+	Body   []byte // the raw response body
+}
+
+// Error returns the string representation of the decode error
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("unable to unmarshal %s, %s", e.Format, e.Err)
+}
+
+// Unwrap returns the underlying decoding error
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
@@ -1,9 +1,23 @@
 package http
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
+
+	"github.com/zivkovicmilos/go-vlc/client"
+)
+
+// maxErrorBodySize is the maximum number of response body bytes
+// kept in a status error
+const maxErrorBodySize = 512
+
+var (
+	_ client.ContextClient = (*Client)(nil)
+	_ client.StreamClient  = (*Client)(nil)
 )
 
 type RequestAuth struct {
@@ -12,24 +26,76 @@ type RequestAuth struct {
 }
 
 type Client struct {
-	baseURL string
+	client  *http.Client
+	headers http.Header
 
-	client *http.Client
-	auth   RequestAuth
+	baseURL   string
+	userAgent string
+
+	auth RequestAuth
 }
 
-// NewClient creates a new instance of the HTTP client
-func NewClient(baseURL string, auth RequestAuth) *Client {
+// NewClient creates a new instance of the HTTP client,
+// with the given options applied
+func NewClient(baseURL string, auth RequestAuth, opts ...Option) *Client {
+	cfg := newConfig()
+
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
 	return &Client{
-		baseURL: baseURL,
-		client:  &http.Client{},
-		auth:    auth,
+		baseURL:   baseURL,
+		client:    cfg.buildHTTPClient(),
+		headers:   cfg.headers,
+		userAgent: cfg.userAgent,
+		auth:      auth,
 	}
 }
 
+// Get executes a GET request on the given endpoint, and returns the response body
 func (c *Client) Get(endpoint string) ([]byte, error) {
+	return c.GetContext(context.Background(), endpoint)
+}
+
+// GetContext executes a GET request on the given endpoint, bound to the given context,
+// and returns the response body
+func (c *Client) GetContext(ctx context.Context, endpoint string) ([]byte, error) {
+	response, err := c.do(ctx, endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		_ = response.Body.Close()
+	}()
+
+	responseBody, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read response body, %w", err)
+	}
+
+	return responseBody, nil
+}
+
+// GetStreamContext executes a GET request on the given endpoint, bound to the given context,
+// and returns the unread response body, along with its content type.
+// The caller is responsible for closing the body
+func (c *Client) GetStreamContext(ctx context.Context, endpoint string) (io.ReadCloser, string, error) {
+	response, err := c.do(ctx, endpoint)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return response.Body, response.Header.Get("Content-Type"), nil
+}
+
+// do executes a GET request on the given endpoint, and returns the response
+// if it has an OK status code. The caller is responsible for closing the body
+func (c *Client) do(ctx context.Context, endpoint string) (*http.Response, error) {
 	// Create the request
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		http.MethodGet,
 		fmt.Sprintf("%s/%s", c.baseURL, endpoint),
 		http.NoBody,
@@ -38,34 +104,56 @@ func (c *Client) Get(endpoint string) ([]byte, error) {
 		return nil, fmt.Errorf("unable to create request, %w", err)
 	}
 
+	// Set the extra headers
+	for key, values := range c.headers {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+
 	// Set the basic auth
 	req.SetBasicAuth(c.auth.Username, c.auth.Password)
 
 	// Run the request
 	response, reqError := c.client.Do(req)
 	if reqError != nil {
+		if isDialError(reqError) {
+			return nil, fmt.Errorf("unable to execute request, %w, %w", client.ErrUnreachable, reqError)
+		}
+
 		return nil, fmt.Errorf("unable to execute request, %w", reqError)
 	}
 
-	defer func() {
-		_ = response.Body.Close()
-	}()
-
 	// Check status code
 	statusCode := response.StatusCode
 	if !isOKResponse(statusCode) {
-		return nil, fmt.Errorf("invalid status code, %d", statusCode)
-	}
+		// Keep a part of the body, for context
+		body, _ := io.ReadAll(io.LimitReader(response.Body, maxErrorBodySize))
 
-	responseBody, err := io.ReadAll(response.Body)
-	if err != nil {
-		return nil, fmt.Errorf("unable to read response body, %w", err)
+		_ = response.Body.Close()
+
+		return nil, &client.StatusError{
+			Endpoint: endpoint,
+			Body:     string(body),
+			Code:     statusCode,
+		}
 	}
 
-	return responseBody, nil
+	return response, nil
 }
 
 // isOKResponse validates the response code is valid
 func isOKResponse(code int) bool {
 	return code >= 200 && code <= 299
 }
+
+// isDialError checks if the error is a connection (dial) failure
+func isDialError(err error) bool {
+	var opErr *net.OpError
+
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
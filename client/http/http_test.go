@@ -1,12 +1,17 @@
 package http
 
 import (
+	"context"
+	"errors"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/zivkovicmilos/go-vlc/client"
 )
 
 // newTestServer creates a new test server instance
@@ -62,3 +67,184 @@ func TestClient_Get(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, response, resp)
 }
+
+func TestClient_GetContext(t *testing.T) {
+	t.Parallel()
+
+	t.Run("request completes", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			response = []byte("response")
+
+			handler = http.HandlerFunc(
+				func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusOK)
+					_, err := w.Write(response)
+
+					require.NoError(t, err)
+				},
+			)
+
+			server = newTestServer(t, handler)
+		)
+
+		client := NewClient(server.URL, RequestAuth{"user", "pass"})
+		resp, err := client.GetContext(context.Background(), "example")
+
+		require.NoError(t, err)
+		assert.Equal(t, response, resp)
+	})
+
+	t.Run("request is cancelled", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			handler = http.HandlerFunc(
+				func(w http.ResponseWriter, r *http.Request) {
+					<-r.Context().Done()
+				},
+			)
+
+			server = newTestServer(t, handler)
+		)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		client := NewClient(server.URL, RequestAuth{"user", "pass"})
+		resp, err := client.GetContext(ctx, "example")
+
+		assert.Nil(t, resp)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestClient_GetStreamContext(t *testing.T) {
+	t.Parallel()
+
+	t.Run("stream returned", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			response = []byte("image data")
+
+			handler = http.HandlerFunc(
+				func(w http.ResponseWriter, r *http.Request) {
+					w.Header().Set("Content-Type", "image/jpeg")
+					w.WriteHeader(http.StatusOK)
+					_, err := w.Write(response)
+
+					require.NoError(t, err)
+				},
+			)
+
+			server = newTestServer(t, handler)
+		)
+
+		c := NewClient(server.URL, RequestAuth{"user", "pass"})
+		body, contentType, err := c.GetStreamContext(context.Background(), "art")
+		require.NoError(t, err)
+
+		defer func() {
+			_ = body.Close()
+		}()
+
+		data, err := io.ReadAll(body)
+		require.NoError(t, err)
+
+		assert.Equal(t, response, data)
+		assert.Equal(t, "image/jpeg", contentType)
+	})
+
+	t.Run("invalid status code", func(t *testing.T) {
+		t.Parallel()
+
+		server := newTestServer(t, http.NotFoundHandler())
+
+		c := NewClient(server.URL, RequestAuth{"user", "pass"})
+		body, contentType, err := c.GetStreamContext(context.Background(), "art")
+
+		assert.Nil(t, body)
+		assert.Empty(t, contentType)
+
+		var statusErr *client.StatusError
+
+		require.True(t, errors.As(err, &statusErr))
+		assert.Equal(t, http.StatusNotFound, statusErr.Code)
+	})
+}
+
+func TestClient_Get_Errors(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unauthorized", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			handler = http.HandlerFunc(
+				func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusUnauthorized)
+				},
+			)
+
+			server = newTestServer(t, handler)
+		)
+
+		c := NewClient(server.URL, RequestAuth{"user", "wrong"})
+		resp, err := c.Get("example")
+
+		assert.Nil(t, resp)
+		assert.ErrorIs(t, err, client.ErrUnauthorized)
+
+		var statusErr *client.StatusError
+
+		require.True(t, errors.As(err, &statusErr))
+		assert.Equal(t, http.StatusUnauthorized, statusErr.Code)
+		assert.Equal(t, "example", statusErr.Endpoint)
+	})
+
+	t.Run("invalid status code with truncated body", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			body = strings.Repeat("a", maxErrorBodySize*2)
+
+			handler = http.HandlerFunc(
+				func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusInternalServerError)
+					_, err := w.Write([]byte(body))
+
+					require.NoError(t, err)
+				},
+			)
+
+			server = newTestServer(t, handler)
+		)
+
+		c := NewClient(server.URL, RequestAuth{"user", "pass"})
+		resp, err := c.Get("example")
+
+		assert.Nil(t, resp)
+		assert.NotErrorIs(t, err, client.ErrUnauthorized)
+
+		var statusErr *client.StatusError
+
+		require.True(t, errors.As(err, &statusErr))
+		assert.Equal(t, http.StatusInternalServerError, statusErr.Code)
+		assert.Equal(t, body[:maxErrorBodySize], statusErr.Body)
+	})
+
+	t.Run("server unreachable", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.NotFoundHandler())
+		server.Close()
+
+		c := NewClient(server.URL, RequestAuth{"user", "pass"})
+		resp, err := c.Get("example")
+
+		assert.Nil(t, resp)
+		assert.ErrorIs(t, err, client.ErrUnreachable)
+	})
+}
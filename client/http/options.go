@@ -0,0 +1,136 @@
+package http
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Option is a functional option for the HTTP client
+type Option func(*config)
+
+// config holds the HTTP client configuration,
+// applied when the client is built
+type config struct {
+	transport http.RoundTripper
+	tlsConfig *tls.Config
+	proxy     func(*http.Request) (*url.URL, error)
+	headers   http.Header
+	userAgent string
+	timeout   time.Duration
+
+	insecureSkipVerify bool
+}
+
+// newConfig creates the default HTTP client configuration
+func newConfig() *config {
+	return &config{
+		headers: make(http.Header),
+	}
+}
+
+// WithTimeout sets the timeout for every request made by the client.
+// A timeout of 0 means no timeout
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *config) {
+		c.timeout = timeout
+	}
+}
+
+// WithTransport sets a custom round tripper for the client.
+// If the transport is not an *http.Transport, the TLS and proxy options are ignored
+func WithTransport(transport http.RoundTripper) Option {
+	return func(c *config) {
+		c.transport = transport
+	}
+}
+
+// WithTLSConfig sets the TLS configuration used by the client,
+// for example, to trust a custom CA
+func WithTLSConfig(tlsConfig *tls.Config) Option {
+	return func(c *config) {
+		c.tlsConfig = tlsConfig
+	}
+}
+
+// WithInsecureSkipVerify disables server certificate verification.
+// Useful for self-signed certificates on a reverse proxy, but should not be used otherwise
+func WithInsecureSkipVerify() Option {
+	return func(c *config) {
+		c.insecureSkipVerify = true
+	}
+}
+
+// WithProxy sets the proxy function used by the client.
+// Use http.ProxyURL for a fixed proxy, or http.ProxyFromEnvironment for the default behavior
+func WithProxy(proxy func(*http.Request) (*url.URL, error)) Option {
+	return func(c *config) {
+		c.proxy = proxy
+	}
+}
+
+// WithUserAgent sets a custom User-Agent header for every request
+func WithUserAgent(userAgent string) Option {
+	return func(c *config) {
+		c.userAgent = userAgent
+	}
+}
+
+// WithHeader adds an extra header to every request
+func WithHeader(key, value string) Option {
+	return func(c *config) {
+		c.headers.Add(key, value)
+	}
+}
+
+// buildHTTPClient constructs the underlying HTTP client from the configuration
+func (c *config) buildHTTPClient() *http.Client {
+	return &http.Client{
+		Transport: c.buildTransport(),
+		Timeout:   c.timeout,
+	}
+}
+
+// buildTransport constructs the round tripper from the configuration.
+// If no round tripper, TLS or proxy option is set, the default transport is used
+func (c *config) buildTransport() http.RoundTripper {
+	transport := c.transport
+
+	if c.tlsConfig == nil && c.proxy == nil && !c.insecureSkipVerify {
+		return transport
+	}
+
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	httpTransport, ok := transport.(*http.Transport)
+	if !ok {
+		// Custom round trippers are used as-is
+		return transport
+	}
+
+	// Clone the transport, so the original is never modified
+	httpTransport = httpTransport.Clone()
+
+	if c.tlsConfig != nil {
+		httpTransport.TLSClientConfig = c.tlsConfig.Clone()
+	}
+
+	if c.insecureSkipVerify {
+		if httpTransport.TLSClientConfig == nil {
+			httpTransport.TLSClientConfig = &tls.Config{
+				MinVersion: tls.VersionTLS12,
+			}
+		}
+
+		httpTransport.TLSClientConfig.InsecureSkipVerify = true //nolint:gosec // explicit opt-in by the caller
+	}
+
+	if c.proxy != nil {
+		httpTransport.Proxy = c.proxy
+	}
+
+	return httpTransport
+}
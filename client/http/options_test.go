@@ -0,0 +1,126 @@
+package http
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// roundTripperFunc is a round tripper adapter for plain functions
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestClient_Options(t *testing.T) {
+	t.Parallel()
+
+	t.Run("default client", func(t *testing.T) {
+		t.Parallel()
+
+		client := NewClient("http://example.com", RequestAuth{})
+
+		assert.Nil(t, client.client.Transport)
+		assert.Zero(t, client.client.Timeout)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		t.Parallel()
+
+		timeout := 5 * time.Second
+
+		client := NewClient("http://example.com", RequestAuth{}, WithTimeout(timeout))
+
+		assert.Equal(t, timeout, client.client.Timeout)
+	})
+
+	t.Run("custom transport", func(t *testing.T) {
+		t.Parallel()
+
+		transport := roundTripperFunc(func(_ *http.Request) (*http.Response, error) {
+			return nil, nil
+		})
+
+		client := NewClient(
+			"http://example.com",
+			RequestAuth{},
+			WithTransport(transport),
+			WithInsecureSkipVerify(), // ignored for custom round trippers
+		)
+
+		assert.NotNil(t, client.client.Transport)
+
+		_, ok := client.client.Transport.(roundTripperFunc)
+		assert.True(t, ok)
+	})
+
+	t.Run("TLS and proxy config", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			tlsConfig = &tls.Config{
+				MinVersion: tls.VersionTLS12,
+				ServerName: "vlc.local",
+			}
+			proxyURL = &url.URL{Scheme: "http", Host: "proxy:3128"}
+		)
+
+		client := NewClient(
+			"http://example.com",
+			RequestAuth{},
+			WithTLSConfig(tlsConfig),
+			WithInsecureSkipVerify(),
+			WithProxy(http.ProxyURL(proxyURL)),
+		)
+
+		transport, ok := client.client.Transport.(*http.Transport)
+		require.True(t, ok)
+
+		assert.Equal(t, "vlc.local", transport.TLSClientConfig.ServerName)
+		assert.True(t, transport.TLSClientConfig.InsecureSkipVerify)
+
+		proxy, err := transport.Proxy(&http.Request{})
+		require.NoError(t, err)
+
+		assert.Equal(t, proxyURL, proxy)
+
+		// Make sure the default transport is untouched
+		assert.NotSame(t, http.DefaultTransport, transport)
+	})
+
+	t.Run("user agent and extra headers", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			userAgent = "go-vlc-test"
+
+			handler = http.HandlerFunc(
+				func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, userAgent, r.Header.Get("User-Agent"))
+					assert.Equal(t, []string{"a", "b"}, r.Header.Values("X-Extra"))
+
+					w.WriteHeader(http.StatusOK)
+				},
+			)
+
+			server = newTestServer(t, handler)
+		)
+
+		client := NewClient(
+			server.URL,
+			RequestAuth{"user", "pass"},
+			WithUserAgent(userAgent),
+			WithHeader("X-Extra", "a"),
+			WithHeader("X-Extra", "b"),
+		)
+
+		_, err := client.Get("example")
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,305 @@
+package client
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+)
+
+// redactedValue is the placeholder for redacted query values
+const redactedValue = "REDACTED"
+
+// Middleware wraps a Client, extending its behavior
+type Middleware func(Client) Client
+
+// ClientFunc is an adapter that allows the use of plain functions as a Client
+type ClientFunc func(ctx context.Context, endpoint string) ([]byte, error)
+
+// Get executes the client function with a background context
+func (f ClientFunc) Get(endpoint string) ([]byte, error) {
+	return f(context.Background(), endpoint)
+}
+
+// GetContext executes the client function
+func (f ClientFunc) GetContext(ctx context.Context, endpoint string) ([]byte, error) {
+	return f(ctx, endpoint)
+}
+
+// StreamFunc is a function that streams the response body, along with its content type
+type StreamFunc func(ctx context.Context, endpoint string) (io.ReadCloser, string, error)
+
+// StreamClientFunc is an adapter that allows the use of plain functions as a StreamClient
+type StreamClientFunc struct {
+	ClientFunc
+
+	StreamFunc StreamFunc
+}
+
+// GetStreamContext executes the stream function
+func (f StreamClientFunc) GetStreamContext(ctx context.Context, endpoint string) (io.ReadCloser, string, error) {
+	return f.StreamFunc(ctx, endpoint)
+}
+
+// withStream adds the stream function to the client function, if the next client is a StreamClient.
+// Otherwise, the client function is returned as-is, so the missing streaming support is still detectable
+func withStream(next Client, get ClientFunc, stream func(next StreamClient) StreamFunc) Client {
+	streamClient, ok := next.(StreamClient)
+	if !ok {
+		return get
+	}
+
+	return StreamClientFunc{
+		ClientFunc: get,
+		StreamFunc: stream(streamClient),
+	}
+}
+
+// Chain wraps the client with the given middlewares.
+// The first middleware is the outermost one, meaning it sees every request first
+func Chain(client Client, middlewares ...Middleware) Client {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		client = middlewares[i](client)
+	}
+
+	return client
+}
+
+// Retry is the middleware variant of the retry client
+func Retry(opts ...RetryOption) Middleware {
+	return func(next Client) Client {
+		return NewRetryClient(next, opts...)
+	}
+}
+
+// Logging logs every request, with its duration and outcome, using the given logger.
+// Successful requests are logged on the debug level, and failed ones on the error level
+func Logging(logger *slog.Logger) Middleware {
+	return func(next Client) Client {
+		// log logs the request outcome, with the extra attributes of a successful request
+		log := func(ctx context.Context, endpoint string, start time.Time, err error, extra ...slog.Attr) {
+			attrs := []slog.Attr{
+				slog.String("endpoint", RedactedEndpoint(ctx, endpoint)),
+				slog.Duration("duration", time.Since(start)),
+			}
+
+			if err != nil {
+				attrs = append(attrs, slog.String("error", RedactError(ctx, endpoint, err).Error()))
+
+				logger.LogAttrs(ctx, slog.LevelError, "VLC request failed", attrs...)
+
+				return
+			}
+
+			attrs = append(attrs, extra...)
+
+			logger.LogAttrs(ctx, slog.LevelDebug, "VLC request executed", attrs...)
+		}
+
+		get := ClientFunc(func(ctx context.Context, endpoint string) ([]byte, error) {
+			start := time.Now()
+
+			response, err := GetWithContext(ctx, next, endpoint)
+
+			log(ctx, endpoint, start, err, slog.Int("size", len(response)))
+
+			if err != nil {
+				return nil, err
+			}
+
+			return response, nil
+		})
+
+		return withStream(next, get, func(next StreamClient) StreamFunc {
+			return func(ctx context.Context, endpoint string) (io.ReadCloser, string, error) {
+				start := time.Now()
+
+				body, contentType, err := next.GetStreamContext(ctx, endpoint)
+
+				// The body isn't read yet, so its size is unknown
+				log(ctx, endpoint, start, err, slog.String("content_type", contentType))
+
+				return body, contentType, err
+			}
+		})
+	}
+}
+
+// TimingFunc is called with the (redacted) endpoint, duration and (redacted) outcome of every request
+type TimingFunc func(endpoint string, duration time.Duration, err error)
+
+// Timing measures the duration of every request, and reports it to the given function
+func Timing(observe TimingFunc) Middleware {
+	return func(next Client) Client {
+		get := ClientFunc(func(ctx context.Context, endpoint string) ([]byte, error) {
+			start := time.Now()
+
+			response, err := GetWithContext(ctx, next, endpoint)
+
+			observe(RedactedEndpoint(ctx, endpoint), time.Since(start), RedactError(ctx, endpoint, err))
+
+			return response, err
+		})
+
+		// Streamed requests are measured until the response headers are received
+		return withStream(next, get, func(next StreamClient) StreamFunc {
+			return func(ctx context.Context, endpoint string) (io.ReadCloser, string, error) {
+				start := time.Now()
+
+				body, contentType, err := next.GetStreamContext(ctx, endpoint)
+
+				observe(RedactedEndpoint(ctx, endpoint), time.Since(start), RedactError(ctx, endpoint, err))
+
+				return body, contentType, err
+			}
+		})
+	}
+}
+
+// redactionKey is the context key for the request redaction
+type redactionKey struct{}
+
+// redaction holds the redacted request details
+type redaction struct {
+	endpoint string   // the endpoint, with the redacted values replaced
+	values   []string // the raw and decoded redacted values, hidden from error messages
+}
+
+// redactedError is an error with the redacted values removed from its message.
+// The original error is still matched by errors.Is and errors.As
+type redactedError struct {
+	err     error
+	message string
+}
+
+// Error returns the redacted error message
+func (e *redactedError) Error() string {
+	return e.message
+}
+
+// Unwrap returns the original error
+func (e *redactedError) Unwrap() error {
+	return e.err
+}
+
+// Redact hides the values of the given query keys (for example, input URIs
+// carrying credentials) from the middlewares that follow it in the chain, and
+// from the messages of the returned errors. The request itself is executed with the original endpoint
+func Redact(keys ...string) Middleware {
+	return func(next Client) Client {
+		// redact adds the request redaction to the context
+		redact := func(ctx context.Context, endpoint string) context.Context {
+			previous, _ := ctx.Value(redactionKey{}).(redaction)
+
+			redacted, values := redactEndpoint(RedactedEndpoint(ctx, endpoint), keys)
+
+			return context.WithValue(ctx, redactionKey{}, redaction{
+				endpoint: redacted,
+				values:   append(values, previous.values...),
+			})
+		}
+
+		get := ClientFunc(func(ctx context.Context, endpoint string) ([]byte, error) {
+			ctx = redact(ctx, endpoint)
+
+			response, err := GetWithContext(ctx, next, endpoint)
+
+			return response, RedactError(ctx, endpoint, err)
+		})
+
+		return withStream(next, get, func(next StreamClient) StreamFunc {
+			return func(ctx context.Context, endpoint string) (io.ReadCloser, string, error) {
+				ctx = redact(ctx, endpoint)
+
+				body, contentType, err := next.GetStreamContext(ctx, endpoint)
+
+				return body, contentType, RedactError(ctx, endpoint, err)
+			}
+		})
+	}
+}
+
+// RedactedEndpoint returns the redacted endpoint for the request, if a Redact middleware
+// precedes the caller in the chain. Otherwise, the original endpoint is returned
+func RedactedEndpoint(ctx context.Context, endpoint string) string {
+	if r, ok := ctx.Value(redactionKey{}).(redaction); ok {
+		return r.endpoint
+	}
+
+	return endpoint
+}
+
+// RedactError returns the error with the redacted values removed from its message, if a Redact
+// middleware precedes the caller in the chain. Otherwise, the original error is returned.
+// Errors such as StatusError carry the raw endpoint in their fields, which are not redacted
+func RedactError(ctx context.Context, endpoint string, err error) error {
+	r, ok := ctx.Value(redactionKey{}).(redaction)
+	if !ok || err == nil {
+		return err
+	}
+
+	// Status and transport errors hold the endpoint as-is
+	message := strings.ReplaceAll(err.Error(), endpoint, r.endpoint)
+
+	for _, value := range r.values {
+		message = strings.ReplaceAll(message, value, redactedValue)
+	}
+
+	return &redactedError{
+		err:     err,
+		message: message,
+	}
+}
+
+// redactEndpoint replaces the query values of the given keys with a placeholder,
+// and returns the replaced values, both raw and decoded
+func redactEndpoint(endpoint string, keys []string) (string, []string) {
+	path, rawQuery, found := strings.Cut(endpoint, "?")
+	if !found {
+		return endpoint, nil
+	}
+
+	var (
+		params = strings.Split(rawQuery, "&")
+		values = make([]string, 0)
+	)
+
+	for i, param := range params {
+		rawKey, rawValue, _ := strings.Cut(param, "=")
+
+		key, err := url.QueryUnescape(rawKey)
+		if err != nil {
+			key = rawKey
+		}
+
+		for _, redactKey := range keys {
+			if key != redactKey {
+				continue
+			}
+
+			params[i] = rawKey + "=" + redactedValue
+
+			values = appendRedactedValue(values, rawValue)
+
+			if value, unescapeErr := url.QueryUnescape(rawValue); unescapeErr == nil {
+				values = appendRedactedValue(values, value)
+			}
+
+			break
+		}
+	}
+
+	return path + "?" + strings.Join(params, "&"), values
+}
+
+// appendRedactedValue adds the value to the redacted values, unless it's empty or already added
+func appendRedactedValue(values []string, value string) []string {
+	if value == "" || value == redactedValue || slices.Contains(values, value) {
+		return values
+	}
+
+	return append(values, value)
+}
@@ -0,0 +1,40 @@
+package client
+
+import (
+	"context"
+	"io"
+)
+
+type getContextDelegate func(context.Context, string) ([]byte, error)
+
+type mockClient struct {
+	getContextFn getContextDelegate
+}
+
+func (m *mockClient) Get(endpoint string) ([]byte, error) {
+	return m.GetContext(context.Background(), endpoint)
+}
+
+func (m *mockClient) GetContext(ctx context.Context, endpoint string) ([]byte, error) {
+	if m.getContextFn != nil {
+		return m.getContextFn(ctx, endpoint)
+	}
+
+	return nil, nil
+}
+
+type getStreamContextDelegate func(context.Context, string) (io.ReadCloser, string, error)
+
+type mockStreamClient struct {
+	mockClient
+
+	getStreamContextFn getStreamContextDelegate
+}
+
+func (m *mockStreamClient) GetStreamContext(ctx context.Context, endpoint string) (io.ReadCloser, string, error) {
+	if m.getStreamContextFn != nil {
+		return m.getStreamContextFn(ctx, endpoint)
+	}
+
+	return nil, "", nil
+}
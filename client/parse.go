@@ -3,7 +3,11 @@ package client
 import (
 	"encoding/json"
 	"encoding/xml"
-	"fmt"
+)
+
+const (
+	formatJSON = "JSON"
+	formatXML  = "XML"
 )
 
 // ParseJSONResponse parses the JSON response into a specific type
@@ -11,7 +15,11 @@ func ParseJSONResponse[T any](rawResponse []byte) (*T, error) {
 	var response T
 
 	if err := json.Unmarshal(rawResponse, &response); err != nil {
-		return nil, fmt.Errorf("unable to unmarshal JSON, %w", err)
+		return nil, &DecodeError{
+			Err:    err,
+			Format: formatJSON,
+			Body:   rawResponse,
+		}
 	}
 
 	return &response, nil
@@ -22,7 +30,11 @@ func ParseXMLResponse[T any](rawResponse []byte) (*T, error) {
 	var response T
 
 	if err := xml.Unmarshal(rawResponse, &response); err != nil {
-		return nil, fmt.Errorf("unable to unmarshal XML, %w", err)
+		return nil, &DecodeError{
+			Err:    err,
+			Format: formatXML,
+			Body:   rawResponse,
+		}
 	}
 
 	return &response, nil
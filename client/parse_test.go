@@ -0,0 +1,76 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testResponse struct {
+	Name string `json:"name" xml:"name"`
+}
+
+func TestParseJSONResponse(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid response", func(t *testing.T) {
+		t.Parallel()
+
+		response, err := ParseJSONResponse[testResponse]([]byte(`{"name":"vlc"}`))
+		require.NoError(t, err)
+
+		assert.Equal(t, "vlc", response.Name)
+	})
+
+	t.Run("invalid response", func(t *testing.T) {
+		t.Parallel()
+
+		raw := []byte("not json")
+
+		response, err := ParseJSONResponse[testResponse](raw)
+
+		assert.Nil(t, response)
+
+		var decodeErr *DecodeError
+
+		require.True(t, errors.As(err, &decodeErr))
+		assert.Equal(t, formatJSON, decodeErr.Format)
+		assert.Equal(t, raw, decodeErr.Body)
+
+		var syntaxErr *json.SyntaxError
+
+		assert.True(t, errors.As(err, &syntaxErr))
+	})
+}
+
+func TestParseXMLResponse(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid response", func(t *testing.T) {
+		t.Parallel()
+
+		response, err := ParseXMLResponse[testResponse]([]byte(`<root><name>vlc</name></root>`))
+		require.NoError(t, err)
+
+		assert.Equal(t, "vlc", response.Name)
+	})
+
+	t.Run("invalid response", func(t *testing.T) {
+		t.Parallel()
+
+		raw := []byte("<root>")
+
+		response, err := ParseXMLResponse[testResponse](raw)
+
+		assert.Nil(t, response)
+
+		var decodeErr *DecodeError
+
+		require.True(t, errors.As(err, &decodeErr))
+		assert.Equal(t, formatXML, decodeErr.Format)
+		assert.Equal(t, raw, decodeErr.Body)
+	})
+}
@@ -0,0 +1,280 @@
+package rc
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/zivkovicmilos/go-vlc"
+)
+
+var errInvalidSeekPosition = errors.New("invalid seek position")
+
+// RC interface commands, as listed by the "help" command
+const (
+	addCommand       = "add"
+	enqueueCommand   = "enqueue"
+	clearCommand     = "clear"
+	playCommand      = "play"
+	pauseCommand     = "pause"
+	stopCommand      = "stop"
+	nextCommand      = "next"
+	prevCommand      = "prev"
+	seekCommand      = "seek"
+	volumeCommand    = "volume"
+	statusCommand    = "status"
+	frameCommand     = "frame"
+	getTimeCommand   = "get_time"
+	getLengthCommand = "get_length"
+	getTitleCommand  = "get_title"
+	isPlayingCommand = "is_playing"
+	titleCommand     = "title"
+	chapterCommand   = "chapter"
+	atrackCommand    = "atrack"
+	vtrackCommand    = "vtrack"
+	strackCommand    = "strack"
+	infoCommand      = "info"
+	statsCommand     = "stats"
+)
+
+var _ vlc.Player = (*Client)(nil)
+
+// run executes a command that has no meaningful reply
+func (c *Client) run(ctx context.Context, command string, args ...string) error {
+	_, err := c.Execute(ctx, command, args...)
+
+	return err
+}
+
+// Play starts playback of the current item
+func (c *Client) Play(ctx context.Context) error {
+	return c.run(ctx, playCommand)
+}
+
+// Pause toggles the playback pause
+func (c *Client) Pause(ctx context.Context) error {
+	return c.run(ctx, pauseCommand)
+}
+
+// Stop stops the playback
+func (c *Client) Stop(ctx context.Context) error {
+	return c.run(ctx, stopCommand)
+}
+
+// Next plays the next item in the playlist
+func (c *Client) Next(ctx context.Context) error {
+	return c.run(ctx, nextCommand)
+}
+
+// Previous plays the previous item in the playlist
+func (c *Client) Previous(ctx context.Context) error {
+	return c.run(ctx, prevCommand)
+}
+
+// Add adds the given MRL to the playlist, and starts playing it
+func (c *Client) Add(ctx context.Context, mrl string) error {
+	return c.run(ctx, addCommand, mrl)
+}
+
+// Enqueue adds the given MRL to the playlist
+func (c *Client) Enqueue(ctx context.Context, mrl string) error {
+	return c.run(ctx, enqueueCommand, mrl)
+}
+
+// Clear empties the playlist
+func (c *Client) Clear(ctx context.Context) error {
+	return c.run(ctx, clearCommand)
+}
+
+// Seek seeks the current item to the given (absolute) position, in seconds precision
+func (c *Client) Seek(ctx context.Context, position time.Duration) error {
+	if position < 0 {
+		return errInvalidSeekPosition
+	}
+
+	return c.run(ctx, seekCommand, strconv.FormatInt(int64(position/time.Second), 10))
+}
+
+// Volume returns the current volume [0, 512], where 256 is 100%
+func (c *Client) Volume(ctx context.Context) (int, error) {
+	reply, err := c.Execute(ctx, volumeCommand)
+	if err != nil {
+		return 0, err
+	}
+
+	line, err := parseSingleLine(reply)
+	if err != nil {
+		return 0, err
+	}
+
+	return parseInt(line)
+}
+
+// SetVolumeLevel sets the volume [0, 512], where 256 is 100%
+func (c *Client) SetVolumeLevel(ctx context.Context, level int) error {
+	return c.run(ctx, volumeCommand, strconv.Itoa(level))
+}
+
+// State returns the current playback state
+func (c *Client) State(ctx context.Context) (*vlc.PlayerState, error) {
+	status, err := c.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	state := &vlc.PlayerState{
+		State:  status.State,
+		Volume: status.Volume,
+	}
+
+	// Time information is only available for a running input
+	if status.Input == "" {
+		return state, nil
+	}
+
+	if state.Time, err = c.Time(ctx); err != nil {
+		return nil, err
+	}
+
+	if state.Length, err = c.Length(ctx); err != nil {
+		return nil, err
+	}
+
+	return state, nil
+}
+
+// Status returns the current input, volume and playback state
+func (c *Client) Status(ctx context.Context) (*Status, error) {
+	reply, err := c.Execute(ctx, statusCommand)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseStatus(reply)
+}
+
+// NextFrame advances the playback by a single frame, pausing it
+func (c *Client) NextFrame(ctx context.Context) error {
+	return c.run(ctx, frameCommand)
+}
+
+// Time returns the position of the current item
+func (c *Client) Time(ctx context.Context) (time.Duration, error) {
+	reply, err := c.Execute(ctx, getTimeCommand)
+	if err != nil {
+		return 0, err
+	}
+
+	return parseSeconds(reply)
+}
+
+// Length returns the length of the current item
+func (c *Client) Length(ctx context.Context) (time.Duration, error) {
+	reply, err := c.Execute(ctx, getLengthCommand)
+	if err != nil {
+		return 0, err
+	}
+
+	return parseSeconds(reply)
+}
+
+// Title returns the title of the current item
+func (c *Client) Title(ctx context.Context) (string, error) {
+	reply, err := c.Execute(ctx, getTitleCommand)
+	if err != nil {
+		return "", err
+	}
+
+	// The title is empty when nothing is playing
+	if len(reply) == 0 {
+		return "", nil
+	}
+
+	return parseSingleLine(reply)
+}
+
+// IsPlaying checks if an item is currently playing
+func (c *Client) IsPlaying(ctx context.Context) (bool, error) {
+	reply, err := c.Execute(ctx, isPlayingCommand)
+	if err != nil {
+		return false, err
+	}
+
+	line, err := parseSingleLine(reply)
+	if err != nil {
+		return false, err
+	}
+
+	return line == "1", nil
+}
+
+// SelectTitle selects the title (DVD, Blu-ray) with the given ID
+func (c *Client) SelectTitle(ctx context.Context, id int) error {
+	return c.run(ctx, titleCommand, strconv.Itoa(id))
+}
+
+// SelectChapter selects the chapter (DVD, Blu-ray) with the given ID
+func (c *Client) SelectChapter(ctx context.Context, id int) error {
+	return c.run(ctx, chapterCommand, strconv.Itoa(id))
+}
+
+// AudioTracks returns the audio tracks of the current item
+func (c *Client) AudioTracks(ctx context.Context) ([]Track, error) {
+	return c.tracks(ctx, atrackCommand)
+}
+
+// SelectAudioTrack selects the audio track with the given ID
+func (c *Client) SelectAudioTrack(ctx context.Context, id int) error {
+	return c.run(ctx, atrackCommand, strconv.Itoa(id))
+}
+
+// VideoTracks returns the video tracks of the current item
+func (c *Client) VideoTracks(ctx context.Context) ([]Track, error) {
+	return c.tracks(ctx, vtrackCommand)
+}
+
+// SelectVideoTrack selects the video track with the given ID
+func (c *Client) SelectVideoTrack(ctx context.Context, id int) error {
+	return c.run(ctx, vtrackCommand, strconv.Itoa(id))
+}
+
+// SubtitleTracks returns the subtitle tracks of the current item
+func (c *Client) SubtitleTracks(ctx context.Context) ([]Track, error) {
+	return c.tracks(ctx, strackCommand)
+}
+
+// SelectSubtitleTrack selects the subtitle track with the given ID
+func (c *Client) SelectSubtitleTrack(ctx context.Context, id int) error {
+	return c.run(ctx, strackCommand, strconv.Itoa(id))
+}
+
+// Info returns the stream information of the current item, per stream
+func (c *Client) Info(ctx context.Context) ([]Section, error) {
+	reply, err := c.Execute(ctx, infoCommand)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseSections(reply), nil
+}
+
+// Stats returns the input and output statistics of the current item, per category
+func (c *Client) Stats(ctx context.Context) ([]Section, error) {
+	reply, err := c.Execute(ctx, statsCommand)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseSections(reply), nil
+}
+
+// tracks fetches the track list using the given track command
+func (c *Client) tracks(ctx context.Context, command string) ([]Track, error) {
+	reply, err := c.Execute(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseTracks(reply)
+}
@@ -0,0 +1,202 @@
+package rc
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var errInvalidReply = errors.New("invalid reply")
+
+var (
+	// trackRegex matches track list entries, such as "| 1 - Track 1 - [English] *"
+	trackRegex = regexp.MustCompile(`^\|\s*(-?\d+)\s+-\s+(.*?)(\s+\*)?$`)
+
+	// sectionRegex matches section headers, such as "+----[ Stream 0 ]" or "+-[Incoming]"
+	sectionRegex = regexp.MustCompile(`^\+-+\[\s*(.*?)\s*\]$`)
+
+	// statusRegex matches status entries, such as "( audio volume: 256 )"
+	statusRegex = regexp.MustCompile(`^\(\s*(.*?)\s*\)$`)
+)
+
+// Track is a single elementary stream track (audio, video, subtitle)
+type Track struct {
+	Name     string
+	ID       int
+	Selected bool
+}
+
+// Section is a named group of key-value fields,
+// as reported by the info and stats commands
+type Section struct {
+	Fields map[string]string
+	Name   string
+}
+
+// Status is the RC status command reply
+type Status struct {
+	Input  string // the current input MRL, if any
+	State  string // [playing, paused, stopped]
+	Volume int
+}
+
+// parseTracks parses the track list reply:
+//
+//	+----[ Audio Track ]
+//	| -1 - Disable
+//	| 1 - Track 1 - [English] *
+//	+----[ end of Audio Track ]
+func parseTracks(lines []string) ([]Track, error) {
+	tracks := make([]Track, 0, len(lines))
+
+	for _, line := range lines {
+		if strings.HasPrefix(line, "+") {
+			continue
+		}
+
+		matches := trackRegex.FindStringSubmatch(line)
+		if matches == nil {
+			return nil, fmt.Errorf("%w, unexpected track line, %q", errInvalidReply, line)
+		}
+
+		id, err := strconv.Atoi(matches[1])
+		if err != nil {
+			return nil, fmt.Errorf("%w, invalid track ID, %w", errInvalidReply, err)
+		}
+
+		tracks = append(tracks, Track{
+			ID:       id,
+			Name:     matches[2],
+			Selected: matches[3] != "",
+		})
+	}
+
+	return tracks, nil
+}
+
+// parseSections parses the info and stats replies:
+//
+//	+----[ Stream 0 ]
+//	|
+//	| Type: Video
+//	| Codec: H264 - MPEG-4 AVC (part 10) (avc1)
+//	+----[ end of stream info ]
+//
+// Section end markers, and lines outside a section are skipped
+func parseSections(lines []string) []Section {
+	var (
+		sections = make([]Section, 0)
+		current  *Section
+	)
+
+	for _, line := range lines {
+		if matches := sectionRegex.FindStringSubmatch(line); matches != nil {
+			name := matches[1]
+
+			if strings.HasPrefix(name, "end of") || strings.HasPrefix(name, "begin of") {
+				current = nil
+
+				continue
+			}
+
+			sections = append(sections, Section{
+				Name:   name,
+				Fields: make(map[string]string),
+			})
+
+			current = &sections[len(sections)-1]
+
+			continue
+		}
+
+		if current == nil {
+			continue
+		}
+
+		key, value, found := strings.Cut(strings.TrimPrefix(line, "|"), ":")
+		if !found {
+			continue
+		}
+
+		current.Fields[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+
+	return sections
+}
+
+// parseStatus parses the status reply:
+//
+//	( new input: file:///media/movie.mkv )
+//	( audio volume: 256 )
+//	( state playing )
+func parseStatus(lines []string) (*Status, error) {
+	status := &Status{}
+
+	for _, line := range lines {
+		matches := statusRegex.FindStringSubmatch(line)
+		if matches == nil {
+			continue
+		}
+
+		entry := matches[1]
+
+		switch {
+		case strings.HasPrefix(entry, "new input:"):
+			status.Input = strings.TrimSpace(strings.TrimPrefix(entry, "new input:"))
+		case strings.HasPrefix(entry, "audio volume:"):
+			volume, err := parseInt(strings.TrimPrefix(entry, "audio volume:"))
+			if err != nil {
+				return nil, err
+			}
+
+			status.Volume = volume
+		case strings.HasPrefix(entry, "state "):
+			status.State = strings.TrimSpace(strings.TrimPrefix(entry, "state "))
+		}
+	}
+
+	return status, nil
+}
+
+// parseSingleLine returns the only line of the reply
+func parseSingleLine(lines []string) (string, error) {
+	if len(lines) != 1 {
+		return "", fmt.Errorf("%w, expected a single line, got %d", errInvalidReply, len(lines))
+	}
+
+	return strings.TrimSpace(lines[0]), nil
+}
+
+// parseInt parses a numeric reply value
+func parseInt(value string) (int, error) {
+	// Volume values can be reported as floats, depending on the VLC version
+	number, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w, invalid number, %w", errInvalidReply, err)
+	}
+
+	return int(number), nil
+}
+
+// parseSeconds parses a reply holding a number of seconds
+func parseSeconds(lines []string) (time.Duration, error) {
+	line, err := parseSingleLine(lines)
+	if err != nil {
+		return 0, err
+	}
+
+	// Nothing is playing
+	if line == "" {
+		return 0, nil
+	}
+
+	seconds, err := parseInt(line)
+	if err != nil {
+		return 0, err
+	}
+
+	return time.Duration(seconds) * time.Second, nil
+}
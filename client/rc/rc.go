@@ -0,0 +1,222 @@
+/*
+Package rc implements a client for the VLC RC (remote control) interface, exposed over TCP with:
+
+	vlc --extraintf rc --rc-host 127.0.0.1:4212
+
+The RC interface is a line protocol, where every command reply is terminated with a "> " prompt.
+*/
+package rc
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrUnknownCommand is returned when the RC interface doesn't recognize the command
+	ErrUnknownCommand = errors.New("unknown command")
+
+	errConnectionBroken = errors.New("connection is out of sync, and must be reopened")
+	errInvalidCommand   = errors.New("invalid command")
+)
+
+const (
+	// prompt is the RC interface prompt, printed after every command reply
+	prompt = "> "
+
+	// statusChangePrefix is the prefix of asynchronous status change notifications
+	statusChangePrefix = "status change:"
+
+	// unknownCommandPrefix is the prefix of the unknown command reply
+	unknownCommandPrefix = "Unknown command"
+)
+
+// Client is the VLC RC interface client.
+// It is safe for concurrent use, but commands are executed sequentially
+type Client struct {
+	conn   net.Conn
+	reader *bufio.Reader
+
+	// err is the sticky error set when a command
+	// reply is not read fully, and the stream is out of sync
+	err error
+
+	mu sync.Mutex
+
+	// ready is set once the connection banner is consumed
+	ready bool
+}
+
+// Dial connects to the RC interface at the given address (host:port)
+func Dial(ctx context.Context, address string) (*Client, error) {
+	var dialer net.Dialer
+
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to RC interface, %w", err)
+	}
+
+	c := NewClient(conn)
+
+	// Consume the connection banner
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.sync(ctx); err != nil {
+		_ = conn.Close()
+
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// NewClient creates a new RC client on top of the given connection.
+// The connection banner is consumed before the first command
+func NewClient(conn net.Conn) *Client {
+	return &Client{
+		conn:   conn,
+		reader: bufio.NewReader(conn),
+	}
+}
+
+// Close closes the RC connection
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// Execute runs the given RC command, with optional arguments,
+// and returns the reply lines
+func (c *Client) Execute(ctx context.Context, command string, args ...string) ([]string, error) {
+	line := strings.Join(append([]string{command}, args...), " ")
+
+	// Commands are newline terminated, so they can't span lines
+	if command == "" || strings.ContainsAny(line, "\r\n") {
+		return nil, errInvalidCommand
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.sync(ctx); err != nil {
+		return nil, err
+	}
+
+	var reply []string
+
+	err := c.withContext(ctx, func() error {
+		if _, err := c.conn.Write([]byte(line + "\n")); err != nil {
+			return fmt.Errorf("unable to write command, %w", err)
+		}
+
+		var readErr error
+
+		reply, readErr = c.readReply()
+
+		return readErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(reply) > 0 && strings.HasPrefix(reply[0], unknownCommandPrefix) {
+		return nil, fmt.Errorf("%w, %s", ErrUnknownCommand, command)
+	}
+
+	return reply, nil
+}
+
+// sync consumes the connection banner, if it hasn't been consumed yet
+func (c *Client) sync(ctx context.Context) error {
+	if c.err != nil {
+		return c.err
+	}
+
+	if c.ready {
+		return nil
+	}
+
+	if err := c.withContext(ctx, func() error {
+		_, err := c.readReply()
+
+		return err
+	}); err != nil {
+		return err
+	}
+
+	c.ready = true
+
+	return nil
+}
+
+// withContext runs the given connection operation, aborting it when the context is done.
+// If the operation fails, the client is marked as broken, since the stream is out of sync
+func (c *Client) withContext(ctx context.Context, operation func() error) error {
+	// Clear any deadline left over from a previous operation
+	if err := c.conn.SetDeadline(time.Time{}); err != nil {
+		return fmt.Errorf("unable to set deadline, %w", err)
+	}
+
+	// Unblock the operation when the context is done
+	stop := context.AfterFunc(ctx, func() {
+		_ = c.conn.SetDeadline(time.Now())
+	})
+
+	err := operation()
+
+	stop()
+
+	if err == nil {
+		return nil
+	}
+
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		err = fmt.Errorf("%w, %w", ctxErr, err)
+	}
+
+	c.err = fmt.Errorf("%w, %w", errConnectionBroken, err)
+
+	return err
+}
+
+// readReply reads the reply lines, up until the prompt.
+// Asynchronous status change notifications are skipped
+func (c *Client) readReply() ([]string, error) {
+	var (
+		lines   = make([]string, 0)
+		current strings.Builder
+	)
+
+	for {
+		b, err := c.reader.ReadByte()
+		if err != nil {
+			return nil, fmt.Errorf("unable to read reply, %w", err)
+		}
+
+		if b != '\n' {
+			current.WriteByte(b)
+
+			// The prompt is never newline terminated
+			if current.String() == prompt {
+				return lines, nil
+			}
+
+			continue
+		}
+
+		line := strings.TrimSuffix(current.String(), "\r")
+		current.Reset()
+
+		if strings.HasPrefix(line, statusChangePrefix) {
+			continue
+		}
+
+		lines = append(lines, line)
+	}
+}
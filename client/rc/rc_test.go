@@ -0,0 +1,391 @@
+package rc
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const banner = "VLC media player 3.0.18 Vetinari\r\nCommand Line Interface initialized. Type `help' for help.\r\n"
+
+// fakeServer is an in-process fake of the VLC RC interface
+type fakeServer struct {
+	listener net.Listener
+	replies  map[string]string
+
+	commands []string
+	mu       sync.Mutex
+}
+
+// newFakeServer starts a fake RC server, with the given command replies
+func newFakeServer(t *testing.T, replies map[string]string) *fakeServer {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	s := &fakeServer{
+		listener: listener,
+		replies:  replies,
+	}
+
+	go s.serve()
+
+	t.Cleanup(func() {
+		_ = listener.Close()
+	})
+
+	return s
+}
+
+// serve accepts and handles connections, until the listener is closed
+func (s *fakeServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		go s.handle(conn)
+	}
+}
+
+// handle serves the RC line protocol on the given connection
+func (s *fakeServer) handle(conn net.Conn) {
+	defer conn.Close()
+
+	if _, err := conn.Write([]byte(banner + prompt)); err != nil {
+		return
+	}
+
+	scanner := bufio.NewScanner(conn)
+
+	for scanner.Scan() {
+		command := scanner.Text()
+
+		s.mu.Lock()
+		s.commands = append(s.commands, command)
+		s.mu.Unlock()
+
+		name, _, _ := strings.Cut(command, " ")
+
+		reply, ok := s.replies[command]
+		if !ok {
+			reply, ok = s.replies[name]
+		}
+
+		if !ok {
+			reply = "Unknown command `" + name + "'. Type `help' for help.\r\n"
+		}
+
+		if _, err := conn.Write([]byte(reply + prompt)); err != nil {
+			return
+		}
+	}
+}
+
+// received returns the commands received by the server
+func (s *fakeServer) received() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]string(nil), s.commands...)
+}
+
+// dial connects a client to the fake server
+func dial(t *testing.T, s *fakeServer) *Client {
+	t.Helper()
+
+	c, err := Dial(context.Background(), s.listener.Addr().String())
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = c.Close()
+	})
+
+	return c
+}
+
+func TestClient_Execute(t *testing.T) {
+	t.Parallel()
+
+	t.Run("command with reply", func(t *testing.T) {
+		t.Parallel()
+
+		server := newFakeServer(t, map[string]string{
+			getTitleCommand: "status change: ( new title: 1 )\r\nBig Buck Bunny\r\n",
+		})
+
+		c := dial(t, server)
+
+		title, err := c.Title(context.Background())
+		require.NoError(t, err)
+
+		assert.Equal(t, "Big Buck Bunny", title)
+	})
+
+	t.Run("unknown command", func(t *testing.T) {
+		t.Parallel()
+
+		c := dial(t, newFakeServer(t, nil))
+
+		reply, err := c.Execute(context.Background(), "unknown")
+
+		assert.Nil(t, reply)
+		assert.ErrorIs(t, err, ErrUnknownCommand)
+	})
+
+	t.Run("multi-line command", func(t *testing.T) {
+		t.Parallel()
+
+		c := dial(t, newFakeServer(t, nil))
+
+		reply, err := c.Execute(context.Background(), "add", "file:///a\nshutdown")
+
+		assert.Nil(t, reply)
+		assert.ErrorIs(t, err, errInvalidCommand)
+	})
+
+	t.Run("commands on a lazily synced connection", func(t *testing.T) {
+		t.Parallel()
+
+		server := newFakeServer(t, map[string]string{
+			isPlayingCommand: "1\r\n",
+		})
+
+		conn, err := net.Dial("tcp", server.listener.Addr().String())
+		require.NoError(t, err)
+
+		c := NewClient(conn)
+
+		t.Cleanup(func() {
+			_ = c.Close()
+		})
+
+		playing, err := c.IsPlaying(context.Background())
+		require.NoError(t, err)
+
+		assert.True(t, playing)
+	})
+
+	t.Run("cancelled command breaks the connection", func(t *testing.T) {
+		t.Parallel()
+
+		// The server never replies to commands
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+
+		t.Cleanup(func() {
+			_ = listener.Close()
+		})
+
+		go func() {
+			conn, acceptErr := listener.Accept()
+			if acceptErr != nil {
+				return
+			}
+
+			_, _ = conn.Write([]byte(banner + prompt))
+		}()
+
+		c, err := Dial(context.Background(), listener.Addr().String())
+		require.NoError(t, err)
+
+		t.Cleanup(func() {
+			_ = c.Close()
+		})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		_, err = c.Execute(ctx, statusCommand)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+		_, err = c.Execute(context.Background(), statusCommand)
+		assert.ErrorIs(t, err, errConnectionBroken)
+	})
+}
+
+func TestClient_Player(t *testing.T) {
+	t.Parallel()
+
+	server := newFakeServer(t, map[string]string{
+		playCommand:      "",
+		pauseCommand:     "",
+		stopCommand:      "",
+		nextCommand:      "",
+		prevCommand:      "",
+		addCommand:       "",
+		enqueueCommand:   "",
+		clearCommand:     "",
+		seekCommand:      "",
+		"volume 300":     "",
+		volumeCommand:    "256\r\n",
+		getTimeCommand:   "42\r\n",
+		getLengthCommand: "596\r\n",
+		statusCommand: "( new input: file:///media/Big%20Buck%20Bunny.mp4 )\r\n" +
+			"( audio volume: 256 )\r\n" +
+			"( state playing )\r\n",
+	})
+
+	var (
+		c   = dial(t, server)
+		ctx = context.Background()
+	)
+
+	require.NoError(t, c.Play(ctx))
+	require.NoError(t, c.Pause(ctx))
+	require.NoError(t, c.Stop(ctx))
+	require.NoError(t, c.Next(ctx))
+	require.NoError(t, c.Previous(ctx))
+	require.NoError(t, c.Add(ctx, "file:///media/a b.mp4"))
+	require.NoError(t, c.Enqueue(ctx, "file:///media/c.mp4"))
+	require.NoError(t, c.Clear(ctx))
+	require.NoError(t, c.Seek(ctx, 90*time.Second))
+	require.NoError(t, c.SetVolumeLevel(ctx, 300))
+
+	assert.ErrorIs(t, c.Seek(ctx, -time.Second), errInvalidSeekPosition)
+
+	volume, err := c.Volume(ctx)
+	require.NoError(t, err)
+
+	assert.Equal(t, 256, volume)
+
+	state, err := c.State(ctx)
+	require.NoError(t, err)
+
+	assert.Equal(t, "playing", state.State)
+	assert.Equal(t, 256, state.Volume)
+	assert.Equal(t, 42*time.Second, state.Time)
+	assert.Equal(t, 596*time.Second, state.Length)
+
+	assert.Equal(
+		t,
+		[]string{
+			"play",
+			"pause",
+			"stop",
+			"next",
+			"prev",
+			"add file:///media/a b.mp4",
+			"enqueue file:///media/c.mp4",
+			"clear",
+			"seek 90",
+			"volume 300",
+			"volume",
+			"status",
+			"get_time",
+			"get_length",
+		},
+		server.received(),
+	)
+}
+
+func TestClient_Tracks(t *testing.T) {
+	t.Parallel()
+
+	server := newFakeServer(t, map[string]string{
+		atrackCommand: "+----[ Audio Track ]\r\n" +
+			"| -1 - Disable\r\n" +
+			"| 1 - Track 1 - [English] *\r\n" +
+			"| 2 - Track 2 - [Japanese]\r\n" +
+			"+----[ end of Audio Track ]\r\n",
+		"strack 3": "",
+	})
+
+	c := dial(t, server)
+
+	tracks, err := c.AudioTracks(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(
+		t,
+		[]Track{
+			{ID: -1, Name: "Disable"},
+			{ID: 1, Name: "Track 1 - [English]", Selected: true},
+			{ID: 2, Name: "Track 2 - [Japanese]"},
+		},
+		tracks,
+	)
+
+	require.NoError(t, c.SelectSubtitleTrack(context.Background(), 3))
+}
+
+func TestClient_Sections(t *testing.T) {
+	t.Parallel()
+
+	server := newFakeServer(t, map[string]string{
+		infoCommand: "+----[ Stream 0 ]\r\n" +
+			"| \r\n" +
+			"| Type: Video\r\n" +
+			"| Codec: H264 - MPEG-4 AVC (part 10) (avc1)\r\n" +
+			"| \r\n" +
+			"+----[ Stream 1 ]\r\n" +
+			"| Type: Audio\r\n" +
+			"+----[ end of stream info ]\r\n",
+		statsCommand: "+----[ begin of statistical info ]\r\n" +
+			"+-[Incoming]\r\n" +
+			"| input bytes read :     8 KiB\r\n" +
+			"| input bitrate    :     0 kb/s\r\n" +
+			"+-[Video Decoding]\r\n" +
+			"| video decoded    :     24\r\n" +
+			"+----[ end of statistical info ]\r\n",
+	})
+
+	c := dial(t, server)
+
+	info, err := c.Info(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(
+		t,
+		[]Section{
+			{
+				Name: "Stream 0",
+				Fields: map[string]string{
+					"Type":  "Video",
+					"Codec": "H264 - MPEG-4 AVC (part 10) (avc1)",
+				},
+			},
+			{
+				Name:   "Stream 1",
+				Fields: map[string]string{"Type": "Audio"},
+			},
+		},
+		info,
+	)
+
+	stats, err := c.Stats(context.Background())
+	require.NoError(t, err)
+
+	require.Len(t, stats, 2)
+	assert.Equal(t, "Incoming", stats[0].Name)
+	assert.Equal(t, "8 KiB", stats[0].Fields["input bytes read"])
+	assert.Equal(t, "24", stats[1].Fields["video decoded"])
+}
+
+func TestDial_Unreachable(t *testing.T) {
+	t.Parallel()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	address := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	c, err := Dial(context.Background(), address)
+
+	assert.Nil(t, c)
+
+	var opErr *net.OpError
+
+	assert.True(t, errors.As(err, &opErr))
+}
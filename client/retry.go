@@ -0,0 +1,225 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	defaultMaxRetries     = 3
+	defaultInitialBackoff = 100 * time.Millisecond
+	defaultMaxBackoff     = 2 * time.Second
+	defaultJitter         = 0.2
+)
+
+// readEndpoints are the VLC endpoints that are safe to retry,
+// as long as they don't carry a command
+var readEndpoints = map[string]struct{}{
+	"requests/status.json":   {},
+	"requests/playlist.json": {},
+	"requests/browse.json":   {},
+	"requests/vlm.xml":       {},
+	"art":                    {},
+}
+
+// RetryOption is a functional option for the retry client
+type RetryOption func(*RetryClient)
+
+// WithMaxRetries sets the maximum number of retries, after the initial attempt
+func WithMaxRetries(maxRetries int) RetryOption {
+	return func(c *RetryClient) {
+		c.maxRetries = maxRetries
+	}
+}
+
+// WithBackoff sets the initial and maximum backoff between attempts.
+// The backoff doubles after every failed attempt, up to the maximum
+func WithBackoff(initial, maxBackoff time.Duration) RetryOption {
+	return func(c *RetryClient) {
+		c.initialBackoff = initial
+		c.maxBackoff = maxBackoff
+	}
+}
+
+// WithJitter sets the jitter fraction [0, 1] applied to every backoff.
+// A jitter of 0.2 means the backoff is randomly reduced by up to 20%
+func WithJitter(jitter float64) RetryOption {
+	return func(c *RetryClient) {
+		c.jitter = jitter
+	}
+}
+
+// WithRetryMutating enables retrying mutating commands (pl_next, in_play...).
+// Retrying them can result in the command being executed more than once
+func WithRetryMutating() RetryOption {
+	return func(c *RetryClient) {
+		c.retryMutating = true
+	}
+}
+
+// RetryClient is a Client wrapper that retries failed idempotent requests
+// with exponential backoff and jitter
+type RetryClient struct {
+	client Client
+
+	maxRetries     int
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	jitter         float64
+	retryMutating  bool
+}
+
+// NewRetryClient creates a new retry client wrapping the given client
+func NewRetryClient(client Client, opts ...RetryOption) *RetryClient {
+	c := &RetryClient{
+		client:         client,
+		maxRetries:     defaultMaxRetries,
+		initialBackoff: defaultInitialBackoff,
+		maxBackoff:     defaultMaxBackoff,
+		jitter:         defaultJitter,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// Get executes a GET request, retrying it if it's idempotent
+func (c *RetryClient) Get(endpoint string) ([]byte, error) {
+	return c.GetContext(context.Background(), endpoint)
+}
+
+// GetContext executes a GET request bound to the given context, retrying it if it's idempotent.
+// Retries stop once the context is done
+func (c *RetryClient) GetContext(ctx context.Context, endpoint string) ([]byte, error) {
+	return retryRequest(ctx, c, endpoint, func() ([]byte, error) {
+		return GetWithContext(ctx, c.client, endpoint)
+	})
+}
+
+// GetStreamContext executes a GET request bound to the given context, retrying it if it's idempotent,
+// and returns the response body stream. Only the request is retried, reading the body is not.
+// The body is read into memory if the wrapped client is not a StreamClient
+func (c *RetryClient) GetStreamContext(ctx context.Context, endpoint string) (io.ReadCloser, string, error) {
+	type stream struct {
+		body        io.ReadCloser
+		contentType string
+	}
+
+	response, err := retryRequest(ctx, c, endpoint, func() (stream, error) {
+		body, contentType, err := Stream(ctx, c.client, endpoint)
+
+		return stream{body: body, contentType: contentType}, err
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return response.body, response.contentType, nil
+}
+
+// retryRequest executes the request, retrying it with backoff if it's idempotent
+func retryRequest[T any](ctx context.Context, c *RetryClient, endpoint string, request func() (T, error)) (T, error) {
+	var empty T
+
+	response, err := request()
+	if err == nil {
+		return response, nil
+	}
+
+	if !c.retryMutating && !IsIdempotent(endpoint) {
+		return empty, err
+	}
+
+	for attempt := 0; attempt < c.maxRetries && isRetryable(err); attempt++ {
+		if waitErr := sleepContext(ctx, c.backoff(attempt)); waitErr != nil {
+			return empty, errors.Join(err, waitErr)
+		}
+
+		response, err = request()
+		if err == nil {
+			return response, nil
+		}
+	}
+
+	return empty, err
+}
+
+// backoff returns the jittered backoff for the given attempt (0-indexed)
+func (c *RetryClient) backoff(attempt int) time.Duration {
+	backoff := c.initialBackoff
+
+	for i := 0; i < attempt && backoff < c.maxBackoff; i++ {
+		backoff *= 2
+	}
+
+	if backoff > c.maxBackoff {
+		backoff = c.maxBackoff
+	}
+
+	if c.jitter > 0 {
+		//nolint:gosec // the jitter doesn't need to be cryptographically secure
+		backoff -= time.Duration(rand.Float64() * c.jitter * float64(backoff))
+	}
+
+	return backoff
+}
+
+// IsIdempotent checks if the given endpoint only reads state from VLC,
+// meaning it can be safely executed more than once
+func IsIdempotent(endpoint string) bool {
+	path, rawQuery, _ := strings.Cut(endpoint, "?")
+
+	if _, ok := readEndpoints[strings.TrimPrefix(path, "/")]; !ok {
+		return false
+	}
+
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return false
+	}
+
+	// Any command mutates the VLC state
+	return !query.Has("command")
+}
+
+// isRetryable checks if the request error is transient
+func isRetryable(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var decodeErr *DecodeError
+	if errors.As(err, &decodeErr) {
+		return false
+	}
+
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		// Only server errors are transient
+		return statusErr.Code >= http.StatusInternalServerError
+	}
+
+	return true
+}
+
+// sleepContext waits for the given duration, or until the context is done
+func sleepContext(ctx context.Context, duration time.Duration) error {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,243 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newFailingClient creates a mock client that fails the first n requests
+func newFailingClient(t *testing.T, failures int, failErr error, calls *int) *mockClient {
+	t.Helper()
+
+	return &mockClient{
+		getContextFn: func(_ context.Context, _ string) ([]byte, error) {
+			*calls++
+
+			if *calls <= failures {
+				return nil, failErr
+			}
+
+			return []byte("response"), nil
+		},
+	}
+}
+
+func TestIsIdempotent(t *testing.T) {
+	t.Parallel()
+
+	testTable := []struct {
+		name       string
+		endpoint   string
+		idempotent bool
+	}{
+		{"status", "requests/status.json", true},
+		{"playlist", "requests/playlist.json", true},
+		{"browse", "requests/browse.json?uri=file:///", true},
+		{"vlm", "requests/vlm.xml", true},
+		{"art", "art?item=4", true},
+		{"status command", "requests/status.json?command=pl_next", false},
+		{"vlm command", "requests/vlm_cmd.xml?command=show", false},
+		{"unknown endpoint", "requests/unknown.json", false},
+	}
+
+	for _, testCase := range testTable {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, testCase.idempotent, IsIdempotent(testCase.endpoint))
+		})
+	}
+}
+
+func TestRetryClient_GetContext(t *testing.T) {
+	t.Parallel()
+
+	var (
+		transientErr = errors.New("connection reset")
+		fastBackoff  = WithBackoff(time.Millisecond, 2*time.Millisecond)
+	)
+
+	t.Run("idempotent request is retried", func(t *testing.T) {
+		t.Parallel()
+
+		calls := 0
+		c := NewRetryClient(newFailingClient(t, 2, transientErr, &calls), fastBackoff)
+
+		response, err := c.Get("requests/status.json")
+		require.NoError(t, err)
+
+		assert.Equal(t, []byte("response"), response)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("retries are exhausted", func(t *testing.T) {
+		t.Parallel()
+
+		calls := 0
+		c := NewRetryClient(
+			newFailingClient(t, 10, transientErr, &calls),
+			fastBackoff,
+			WithMaxRetries(2),
+		)
+
+		response, err := c.Get("requests/playlist.json")
+
+		assert.Nil(t, response)
+		assert.ErrorIs(t, err, transientErr)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("mutating request is not retried", func(t *testing.T) {
+		t.Parallel()
+
+		calls := 0
+		c := NewRetryClient(newFailingClient(t, 1, transientErr, &calls), fastBackoff)
+
+		response, err := c.Get("requests/status.json?command=pl_next")
+
+		assert.Nil(t, response)
+		assert.ErrorIs(t, err, transientErr)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("mutating request is retried when opted in", func(t *testing.T) {
+		t.Parallel()
+
+		calls := 0
+		c := NewRetryClient(
+			newFailingClient(t, 1, transientErr, &calls),
+			fastBackoff,
+			WithRetryMutating(),
+		)
+
+		_, err := c.Get("requests/status.json?command=pl_next")
+		require.NoError(t, err)
+
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("client errors are not retried", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			calls     = 0
+			statusErr = &StatusError{Code: http.StatusUnauthorized}
+		)
+
+		c := NewRetryClient(newFailingClient(t, 1, statusErr, &calls), fastBackoff)
+
+		_, err := c.Get("requests/status.json")
+
+		assert.ErrorIs(t, err, ErrUnauthorized)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("server errors are retried", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			calls     = 0
+			statusErr = &StatusError{Code: http.StatusServiceUnavailable}
+		)
+
+		c := NewRetryClient(newFailingClient(t, 1, statusErr, &calls), fastBackoff)
+
+		_, err := c.Get("requests/status.json")
+		require.NoError(t, err)
+
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("context cancellation stops retries", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		c := NewRetryClient(
+			&mockClient{
+				getContextFn: func(_ context.Context, _ string) ([]byte, error) {
+					cancel()
+
+					return nil, transientErr
+				},
+			},
+			WithBackoff(time.Minute, time.Minute),
+		)
+
+		_, err := c.GetContext(ctx, "requests/status.json")
+
+		assert.ErrorIs(t, err, transientErr)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestRetryClient_GetStreamContext(t *testing.T) {
+	t.Parallel()
+
+	var (
+		calls int
+
+		c = NewRetryClient(
+			&mockStreamClient{
+				getStreamContextFn: func(_ context.Context, _ string) (io.ReadCloser, string, error) {
+					calls++
+
+					if calls == 1 {
+						return nil, "", &StatusError{Code: http.StatusServiceUnavailable}
+					}
+
+					return io.NopCloser(strings.NewReader("image")), "image/jpeg", nil
+				},
+			},
+			WithBackoff(time.Millisecond, 2*time.Millisecond),
+		)
+	)
+
+	body, contentType, err := c.GetStreamContext(context.Background(), "art?item=4")
+	require.NoError(t, err)
+
+	data, err := io.ReadAll(body)
+	require.NoError(t, err)
+
+	assert.Equal(t, []byte("image"), data)
+	assert.Equal(t, "image/jpeg", contentType)
+	assert.Equal(t, 2, calls)
+}
+
+func TestRetryClient_Backoff(t *testing.T) {
+	t.Parallel()
+
+	c := NewRetryClient(
+		&mockClient{},
+		WithBackoff(100*time.Millisecond, time.Second),
+		WithJitter(0),
+	)
+
+	assert.Equal(t, 100*time.Millisecond, c.backoff(0))
+	assert.Equal(t, 200*time.Millisecond, c.backoff(1))
+	assert.Equal(t, 800*time.Millisecond, c.backoff(3))
+	assert.Equal(t, time.Second, c.backoff(10))
+
+	jittered := NewRetryClient(
+		&mockClient{},
+		WithBackoff(100*time.Millisecond, time.Second),
+		WithJitter(0.5),
+	)
+
+	for i := 0; i < 10; i++ {
+		backoff := jittered.backoff(0)
+
+		assert.GreaterOrEqual(t, backoff, 50*time.Millisecond)
+		assert.LessOrEqual(t, backoff, 100*time.Millisecond)
+	}
+}
@@ -0,0 +1,255 @@
+/*
+Package telnet implements a client for the VLC telnet interface, and its VLM shell, exposed with:
+
+	vlc --extraintf telnet --telnet-host 127.0.0.1 --telnet-port 4212 --telnet-password 1234
+
+After a password login, every VLM command reply is terminated with a "> " prompt.
+*/
+package telnet
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrWrongPassword is returned when the telnet interface rejects the password
+	ErrWrongPassword = errors.New("wrong password")
+
+	errConnectionBroken = errors.New("connection is out of sync, and must be reopened")
+	errInvalidCommand   = errors.New("invalid command")
+	errUnexpectedPrompt = errors.New("unexpected prompt")
+)
+
+const (
+	// prompt is the VLM shell prompt, printed after every command reply
+	prompt = "> "
+
+	// passwordPrompt is the login password prompt
+	passwordPrompt = "Password: "
+)
+
+// Telnet protocol bytes, as per RFC 854
+const (
+	iac  = 0xFF // interpret as command
+	will = 0xFB
+	dont = 0xFE
+)
+
+// Client is the VLC telnet interface client.
+// It is safe for concurrent use, but commands are executed sequentially
+type Client struct {
+	conn   net.Conn
+	reader *bufio.Reader
+
+	// err is the sticky error set when a command
+	// reply is not read fully, and the stream is out of sync
+	err error
+
+	mu sync.Mutex
+}
+
+// Dial connects to the telnet interface at the given address (host:port), and logs in
+func Dial(ctx context.Context, address, password string) (*Client, error) {
+	var dialer net.Dialer
+
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to telnet interface, %w", err)
+	}
+
+	c, err := NewClient(ctx, conn, password)
+	if err != nil {
+		_ = conn.Close()
+
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// NewClient creates a new telnet client on top of the given connection, and logs in
+func NewClient(ctx context.Context, conn net.Conn, password string) (*Client, error) {
+	c := &Client{
+		conn:   conn,
+		reader: bufio.NewReader(conn),
+	}
+
+	if err := c.login(ctx, password); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// Close closes the telnet connection
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// ExecuteRaw runs the given VLM command, and returns the raw reply lines
+func (c *Client) ExecuteRaw(ctx context.Context, command string) ([]string, error) {
+	// Commands are newline terminated, so they can't span lines
+	if strings.TrimSpace(command) == "" || strings.ContainsAny(command, "\r\n") {
+		return nil, errInvalidCommand
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	var reply []string
+
+	err := c.withContext(ctx, func() error {
+		if _, err := c.conn.Write([]byte(command + "\r\n")); err != nil {
+			return fmt.Errorf("unable to write command, %w", err)
+		}
+
+		lines, terminator, err := c.readUntil(prompt)
+		if err != nil {
+			return err
+		}
+
+		if terminator != prompt {
+			return errUnexpectedPrompt
+		}
+
+		reply = lines
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return reply, nil
+}
+
+// login consumes the connection banner, and sends the password
+func (c *Client) login(ctx context.Context, password string) error {
+	if strings.ContainsAny(password, "\r\n") {
+		return ErrWrongPassword
+	}
+
+	return c.withContext(ctx, func() error {
+		if _, _, err := c.readUntil(passwordPrompt); err != nil {
+			return err
+		}
+
+		if _, err := c.conn.Write([]byte(password + "\r\n")); err != nil {
+			return fmt.Errorf("unable to write password, %w", err)
+		}
+
+		// A wrong password results in a new password prompt
+		_, terminator, err := c.readUntil(prompt, passwordPrompt)
+		if err != nil {
+			return err
+		}
+
+		if terminator == passwordPrompt {
+			return ErrWrongPassword
+		}
+
+		return nil
+	})
+}
+
+// withContext runs the given connection operation, aborting it when the context is done.
+// If the operation fails, the client is marked as broken, since the stream is out of sync
+func (c *Client) withContext(ctx context.Context, operation func() error) error {
+	// Clear any deadline left over from a previous operation
+	if err := c.conn.SetDeadline(time.Time{}); err != nil {
+		return fmt.Errorf("unable to set deadline, %w", err)
+	}
+
+	// Unblock the operation when the context is done
+	stop := context.AfterFunc(ctx, func() {
+		_ = c.conn.SetDeadline(time.Now())
+	})
+
+	err := operation()
+
+	stop()
+
+	if err == nil {
+		return nil
+	}
+
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		err = fmt.Errorf("%w, %w", ctxErr, err)
+	}
+
+	c.err = fmt.Errorf("%w, %w", errConnectionBroken, err)
+
+	return err
+}
+
+// readUntil reads the reply lines, up until one of the given (non newline terminated) prompts.
+// Telnet negotiation sequences are skipped
+func (c *Client) readUntil(prompts ...string) ([]string, string, error) {
+	var (
+		lines   = make([]string, 0)
+		current strings.Builder
+	)
+
+	for {
+		b, err := c.readByte()
+		if err != nil {
+			return nil, "", fmt.Errorf("unable to read reply, %w", err)
+		}
+
+		if b != '\n' {
+			current.WriteByte(b)
+
+			for _, p := range prompts {
+				if current.String() == p {
+					return lines, p, nil
+				}
+			}
+
+			continue
+		}
+
+		lines = append(lines, strings.TrimSuffix(current.String(), "\r"))
+		current.Reset()
+	}
+}
+
+// readByte reads a single data byte, skipping telnet commands
+func (c *Client) readByte() (byte, error) {
+	for {
+		b, err := c.reader.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+
+		if b != iac {
+			return b, nil
+		}
+
+		command, err := c.reader.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+
+		switch {
+		case command == iac:
+			// Escaped data byte
+			return iac, nil
+		case command >= will && command <= dont:
+			// Option negotiation, skip the option byte
+			if _, err := c.reader.ReadByte(); err != nil {
+				return 0, err
+			}
+		}
+	}
+}
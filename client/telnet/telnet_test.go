@@ -0,0 +1,230 @@
+package telnet
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testPassword = "1234"
+
+	// Telnet echo negotiation, sent around the password prompt
+	willEcho = "\xff\xfb\x01"
+	wontEcho = "\xff\xfc\x01"
+)
+
+// newFakeServer starts an in-process fake of the VLC telnet interface,
+// with the given VLM command replies
+func newFakeServer(t *testing.T, replies map[string]string) string {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = listener.Close()
+	})
+
+	go func() {
+		for {
+			conn, acceptErr := listener.Accept()
+			if acceptErr != nil {
+				return
+			}
+
+			go handleConn(conn, replies)
+		}
+	}()
+
+	return listener.Addr().String()
+}
+
+// handleConn serves the telnet login and VLM shell on the given connection
+func handleConn(conn net.Conn, replies map[string]string) {
+	defer conn.Close()
+
+	scanner := bufio.NewScanner(conn)
+
+	write := func(data string) bool {
+		_, err := conn.Write([]byte(data))
+
+		return err == nil
+	}
+
+	if !write("VLC media player 3.0.18 Vetinari\r\n" + willEcho + passwordPrompt) {
+		return
+	}
+
+	// Login
+	for {
+		if !scanner.Scan() {
+			return
+		}
+
+		if strings.TrimSpace(scanner.Text()) == testPassword {
+			break
+		}
+
+		if !write("\r\nWrong password\r\n" + passwordPrompt) {
+			return
+		}
+	}
+
+	if !write(wontEcho + "\r\nWelcome, Master\r\n" + prompt) {
+		return
+	}
+
+	// VLM shell
+	for scanner.Scan() {
+		command := strings.TrimSpace(scanner.Text())
+
+		reply, ok := replies[command]
+		if !ok {
+			verb, _, _ := strings.Cut(command, " ")
+			reply = verb + " : Unknown VLM command\r\n"
+		}
+
+		if !write(reply + prompt) {
+			return
+		}
+	}
+}
+
+func TestDial(t *testing.T) {
+	t.Parallel()
+
+	t.Run("successful login", func(t *testing.T) {
+		t.Parallel()
+
+		c, err := Dial(context.Background(), newFakeServer(t, nil), testPassword)
+		require.NoError(t, err)
+
+		assert.NoError(t, c.Close())
+	})
+
+	t.Run("wrong password", func(t *testing.T) {
+		t.Parallel()
+
+		c, err := Dial(context.Background(), newFakeServer(t, nil), "wrong")
+
+		assert.Nil(t, c)
+		assert.ErrorIs(t, err, ErrWrongPassword)
+	})
+
+	t.Run("login timeout", func(t *testing.T) {
+		t.Parallel()
+
+		// The server never sends the password prompt
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+
+		t.Cleanup(func() {
+			_ = listener.Close()
+		})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		c, err := Dial(ctx, listener.Addr().String(), testPassword)
+
+		assert.Nil(t, c)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
+
+func TestClient_Execute(t *testing.T) {
+	t.Parallel()
+
+	showReply := "show\r\n" +
+		"    media : ( 1 broadcast - 0 vod )\r\n" +
+		"        movie\r\n" +
+		"            type : broadcast\r\n" +
+		"            enabled : yes\r\n" +
+		"            inputs\r\n" +
+		"                1 : file:///media/movie.mkv\r\n" +
+		"            output : #std{access=http,mux=ts,dst=:8081/movie}\r\n" +
+		"            options\r\n" +
+		"            instances\r\n" +
+		"                instance\r\n" +
+		"                    name : default\r\n" +
+		"                    state : playing\r\n" +
+		"    schedule\r\n"
+
+	addr := newFakeServer(t, map[string]string{
+		"new movie broadcast enabled": "",
+		"show":                        showReply,
+	})
+
+	c, err := Dial(context.Background(), addr, testPassword)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = c.Close()
+	})
+
+	t.Run("command without reply", func(t *testing.T) {
+		t.Parallel()
+
+		nodes, err := c.Execute(context.Background(), "new movie broadcast enabled")
+		require.NoError(t, err)
+
+		assert.Empty(t, nodes)
+	})
+
+	t.Run("failed command", func(t *testing.T) {
+		t.Parallel()
+
+		nodes, err := c.Execute(context.Background(), "control missing play")
+
+		assert.Nil(t, nodes)
+		assert.ErrorIs(t, err, ErrCommandFailed)
+		assert.ErrorContains(t, err, "Unknown VLM command")
+	})
+
+	t.Run("invalid command", func(t *testing.T) {
+		t.Parallel()
+
+		nodes, err := c.Execute(context.Background(), "show\r\nshutdown")
+
+		assert.Nil(t, nodes)
+		assert.ErrorIs(t, err, errInvalidCommand)
+	})
+
+	t.Run("structured reply", func(t *testing.T) {
+		t.Parallel()
+
+		show, err := c.Show(context.Background(), "")
+		require.NoError(t, err)
+
+		assert.Equal(t, "show", show.Name)
+		require.Len(t, show.Children, 2)
+
+		media := show.Child("media")
+		require.NotNil(t, media)
+
+		assert.Equal(t, "( 1 broadcast - 0 vod )", media.Value)
+
+		movie := media.Child("movie")
+		require.NotNil(t, movie)
+
+		assert.Equal(t, "broadcast", movie.Child("type").Value)
+		assert.Equal(t, "file:///media/movie.mkv", movie.Child("inputs").Child("1").Value)
+		assert.Equal(t, "#std{access=http,mux=ts,dst=:8081/movie}", movie.Child("output").Value)
+		assert.Empty(t, movie.Child("options").Children)
+		assert.Equal(
+			t,
+			"playing",
+			movie.Child("instances").Child("instance").Child("state").Value,
+		)
+
+		assert.NotNil(t, show.Child("schedule"))
+		assert.Nil(t, show.Child("missing"))
+	})
+}
@@ -0,0 +1,119 @@
+package telnet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrCommandFailed is returned when VLM rejects the command
+var ErrCommandFailed = errors.New("VLM command failed")
+
+const (
+	// indentWidth is the number of spaces per reply nesting level
+	indentWidth = 4
+
+	// valueSeparator separates the node name and value
+	valueSeparator = " : "
+)
+
+// Node is a single element of the structured VLM reply, such as:
+//
+//	media : ( 1 broadcast - 0 vod )
+//	    movie
+//	        type : broadcast
+type Node struct {
+	Name     string
+	Value    string
+	Children []Node
+}
+
+// Child returns the direct child node with the given name, if any
+func (n *Node) Child(name string) *Node {
+	for i := range n.Children {
+		if n.Children[i].Name == name {
+			return &n.Children[i]
+		}
+	}
+
+	return nil
+}
+
+// Execute runs the given VLM command, and returns the structured reply.
+//
+// Apart from show and help, VLM commands don't reply on success,
+// so any other reply is treated as an error
+func (c *Client) Execute(ctx context.Context, command string) ([]Node, error) {
+	lines, err := c.ExecuteRaw(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := parseNodes(lines)
+
+	verb, _, _ := strings.Cut(strings.TrimSpace(command), " ")
+	if verb == "show" || verb == "help" || len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	message := nodes[0].Name
+	if nodes[0].Value != "" {
+		message = nodes[0].Value
+	}
+
+	return nil, fmt.Errorf("%w, %s", ErrCommandFailed, message)
+}
+
+// Show returns the VLM configuration of the given media or schedule.
+// If the name is empty, the entire configuration is returned
+func (c *Client) Show(ctx context.Context, name string) (*Node, error) {
+	command := strings.TrimSpace("show " + name)
+
+	nodes, err := c.Execute(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(nodes) == 0 {
+		return nil, fmt.Errorf("%w, empty reply for %s", ErrCommandFailed, command)
+	}
+
+	return &nodes[0], nil
+}
+
+// parseNodes parses the indented VLM reply lines into a node tree
+func parseNodes(lines []string) []Node {
+	var (
+		root = &Node{}
+
+		// stack holds the path to the last parsed node, per level
+		stack = []*Node{root}
+	)
+
+	for _, line := range lines {
+		trimmed := strings.TrimLeft(line, " ")
+		if strings.TrimSpace(trimmed) == "" {
+			continue
+		}
+
+		level := (len(line) - len(trimmed)) / indentWidth
+
+		// Clamp the level to the deepest possible parent
+		if level > len(stack)-1 {
+			level = len(stack) - 1
+		}
+
+		name, value, _ := strings.Cut(strings.TrimRight(trimmed, " "), valueSeparator)
+
+		parent := stack[level]
+		parent.Children = append(parent.Children, Node{
+			Name:  strings.TrimSpace(name),
+			Value: strings.TrimSpace(value),
+		})
+
+		stack = append(stack[:level+1], &parent.Children[len(parent.Children)-1])
+	}
+
+	return root.Children
+}
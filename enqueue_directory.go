@@ -0,0 +1,223 @@
+package vlc
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// DirectorySort is the order in which EnqueueDirectory enqueues the files
+type DirectorySort int
+
+const (
+	SortByName    DirectorySort = iota // natural path order (track 2 before track 10)
+	SortByModTime                      // oldest first
+	SortBySize                         // smallest first
+)
+
+// EnqueueDirectoryOptions are the options for EnqueueDirectory
+type EnqueueDirectoryOptions struct {
+	MediaTypes []MediaType   // the media types to enqueue, all of them (audio, video, image) if empty
+	Sort       DirectorySort // the enqueue order, with ties in natural path order
+	MaxDepth   int           // the max recursion depth, if recursive (0 is unlimited)
+	Recursive  bool          // enqueue the files of the subdirectories as well
+	Descending bool          // reverse the sort order
+	Play       bool          // play the first file, instead of only enqueueing it
+}
+
+// EnqueueDirectory enqueues the media files of the remote directory, in the given order, and returns
+// their playlist IDs. With the Play option, the first file is played, and the rest are enqueued.
+//
+// The IDs are found by comparing the playlist before and after the enqueue, so enqueues
+// by other clients at the same time can be mistaken for the directory files
+func (v *VLC) EnqueueDirectory(uri string, opts EnqueueDirectoryOptions) ([]int, error) {
+	return v.EnqueueDirectoryContext(context.Background(), uri, opts)
+}
+
+// EnqueueDirectoryContext is EnqueueDirectory with a context that controls the request lifetime
+func (v *VLC) EnqueueDirectoryContext(ctx context.Context, uri string, opts EnqueueDirectoryOptions) ([]int, error) {
+	files, err := v.listMediaFiles(ctx, uri, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(files) == 0 {
+		return []int{}, nil
+	}
+
+	sortFiles(files, opts.Sort, opts.Descending)
+
+	before, err := v.GetPlaylistContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	uris := make([]string, 0, len(files))
+
+	for i, file := range files {
+		if i == 0 && opts.Play {
+			_, err = v.PlaySourceContext(ctx, file.URI)
+		} else {
+			_, err = v.AddToPlaylistContext(ctx, file.URI)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("unable to enqueue %s, %w", file.URI, err)
+		}
+
+		uris = append(uris, file.URI)
+	}
+
+	after, err := v.GetPlaylistContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := matchEnqueuedItems(newQueueItems(before, after), uris)
+	ids := make([]int, 0, len(items))
+
+	for _, item := range items {
+		id, idErr := item.ItemID()
+		if idErr != nil {
+			return nil, fmt.Errorf("invalid playlist item ID, %s, %w", item.ID, idErr)
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+// listMediaFiles lists the media files of the directory (and its subdirectories, if recursive)
+func (v *VLC) listMediaFiles(ctx context.Context, uri string, opts EnqueueDirectoryOptions) ([]*File, error) {
+	var (
+		files    = make([]*File, 0)
+		maxDepth = 1
+
+		mediaTypes = opts.MediaTypes
+	)
+
+	if opts.Recursive {
+		maxDepth = opts.MaxDepth
+	}
+
+	if len(mediaTypes) == 0 {
+		mediaTypes = []MediaType{MediaAudio, MediaVideo, MediaImage}
+	}
+
+	err := v.WalkContext(ctx, uri, func(file *File, _ int, err error) error {
+		switch {
+		case errors.Is(err, ErrDirectoryLoop):
+			return nil
+		case err != nil:
+			return err
+		case !file.IsDir():
+			files = append(files, file)
+		}
+
+		return nil
+	}, WithMaxDepth(maxDepth), WithMediaTypes(mediaTypes...))
+	if err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
+
+// sortFiles sorts the files in the given order, with ties in natural path order
+func sortFiles(files []*File, order DirectorySort, descending bool) {
+	slices.SortStableFunc(files, func(a, b *File) int {
+		var result int
+
+		switch order {
+		case SortByModTime:
+			result = cmp.Compare(a.ModificationTime, b.ModificationTime)
+		case SortBySize:
+			result = cmp.Compare(a.Size, b.Size)
+		case SortByName:
+		}
+
+		if result == 0 {
+			result = naturalCompare(sortPath(a), sortPath(b))
+		}
+
+		if descending {
+			return -result
+		}
+
+		return result
+	})
+}
+
+// sortPath returns the path the file is sorted by
+func sortPath(file *File) string {
+	if file.Path != "" {
+		return file.Path
+	}
+
+	return file.URI
+}
+
+// naturalCompare compares the strings case-insensitively,
+// with digit runs compared by their numeric value ("a2" < "a10")
+func naturalCompare(a, b string) int {
+	i, j := 0, 0
+
+	for i < len(a) && j < len(b) {
+		if isDigit(a[i]) && isDigit(b[j]) {
+			endA, endB := digitRunEnd(a, i), digitRunEnd(b, j)
+
+			numberA := strings.TrimLeft(a[i:endA], "0")
+			numberB := strings.TrimLeft(b[j:endB], "0")
+
+			// Longer numbers (without leading zeros) are larger
+			if result := cmp.Compare(len(numberA), len(numberB)); result != 0 {
+				return result
+			}
+
+			if result := strings.Compare(numberA, numberB); result != 0 {
+				return result
+			}
+
+			i, j = endA, endB
+
+			continue
+		}
+
+		runeA, sizeA := utf8.DecodeRuneInString(a[i:])
+		runeB, sizeB := utf8.DecodeRuneInString(b[j:])
+
+		if result := cmp.Compare(unicode.ToLower(runeA), unicode.ToLower(runeB)); result != 0 {
+			return result
+		}
+
+		i, j = i+sizeA, j+sizeB
+	}
+
+	if result := cmp.Compare(len(a)-i, len(b)-j); result != 0 {
+		return result
+	}
+
+	// Equal apart from case and leading zeros
+	return strings.Compare(a, b)
+}
+
+// isDigit checks if the byte is an ASCII digit
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// digitRunEnd returns the end index of the digit run starting at the given index
+func digitRunEnd(s string, start int) int {
+	end := start
+	for end < len(s) && isDigit(s[end]) {
+		end++
+	}
+
+	return end
+}
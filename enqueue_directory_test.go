@@ -0,0 +1,264 @@
+package vlc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeQueue is a fake VLC play queue, served over the fake remote filesystem
+type fakeQueue struct {
+	fs       *mockClient
+	enqueued []url.Values
+	items    []Playlist
+	nextID   int
+	mu       sync.Mutex
+}
+
+// newFakeQueue creates a fake play queue, with the given URIs already queued
+func newFakeQueue(t *testing.T, uris ...string) *fakeQueue {
+	t.Helper()
+
+	q := &fakeQueue{
+		fs:       newTestFS(t),
+		enqueued: make([]url.Values, 0),
+		items:    make([]Playlist, 0),
+		nextID:   3,
+	}
+
+	for _, uri := range uris {
+		q.add(uri)
+	}
+
+	return q
+}
+
+// add adds the URI to the end of the queue
+func (q *fakeQueue) add(uri string) {
+	q.items = append(q.items, Playlist{
+		ID:   strconv.Itoa(q.nextID),
+		Type: playlistTypeLeaf,
+		URI:  uri,
+	})
+
+	q.nextID++
+}
+
+// client returns the mock client serving the queue
+func (q *fakeQueue) client(t *testing.T) *mockClient {
+	t.Helper()
+
+	return &mockClient{
+		getContextFn: func(ctx context.Context, endpoint string) ([]byte, error) {
+			if strings.HasPrefix(endpoint, baseBrowse) {
+				return q.fs.GetContext(ctx, endpoint)
+			}
+
+			q.mu.Lock()
+			defer q.mu.Unlock()
+
+			if endpoint == basePlaylist {
+				return json.Marshal(&Playlist{
+					Type: playlistTypeNode,
+					Children: []Playlist{
+						{ID: "1", Type: playlistTypeNode, Name: playlistNodeName, Children: slices.Clone(q.items)},
+						{ID: "2", Type: playlistTypeNode, Name: mediaLibraryNodeName},
+					},
+				})
+			}
+
+			parsed, err := url.Parse(endpoint)
+			require.NoError(t, err)
+
+			query := parsed.Query()
+
+			q.enqueued = append(q.enqueued, query)
+			q.add(query.Get(inputKey))
+
+			return json.Marshal(&Status{})
+		},
+	}
+}
+
+func TestNaturalCompare(t *testing.T) {
+	t.Parallel()
+
+	sorted := []string{
+		"track 1.mp3",
+		"Track 2.mp3",
+		"track 02b.mp3",
+		"track 10.mp3",
+		"track 100.mp3",
+		"track a.mp3",
+		"track10.mp3",
+	}
+
+	shuffled := []string{sorted[5], sorted[2], sorted[6], sorted[0], sorted[4], sorted[3], sorted[1]}
+
+	slices.SortFunc(shuffled, naturalCompare)
+
+	assert.Equal(t, sorted, shuffled)
+
+	assert.Zero(t, naturalCompare("a1", "a1"))
+	assert.NotZero(t, naturalCompare("a01", "a1"))
+}
+
+func TestSortFiles(t *testing.T) {
+	t.Parallel()
+
+	newFiles := func() []*File {
+		return []*File{
+			{Path: "/media/b10.mp3", Size: 10, ModificationTime: 1},
+			{Path: "/media/b2.mp3", Size: 30, ModificationTime: 3},
+			{Path: "/media/a.mp3", Size: 10, ModificationTime: 2},
+		}
+	}
+
+	paths := func(files []*File) []string {
+		result := make([]string, 0, len(files))
+
+		for _, file := range files {
+			result = append(result, file.Path)
+		}
+
+		return result
+	}
+
+	testTable := []struct {
+		name          string
+		expectedPaths []string
+		order         DirectorySort
+		descending    bool
+	}{
+		{"by name", []string{"/media/a.mp3", "/media/b2.mp3", "/media/b10.mp3"}, SortByName, false},
+		{"by name descending", []string{"/media/b10.mp3", "/media/b2.mp3", "/media/a.mp3"}, SortByName, true},
+		{"by modification time", []string{"/media/b10.mp3", "/media/a.mp3", "/media/b2.mp3"}, SortByModTime, false},
+		{"by size, ties by name", []string{"/media/a.mp3", "/media/b10.mp3", "/media/b2.mp3"}, SortBySize, false},
+	}
+
+	for _, testCase := range testTable {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			files := newFiles()
+
+			sortFiles(files, testCase.order, testCase.descending)
+
+			assert.Equal(t, testCase.expectedPaths, paths(files))
+		})
+	}
+}
+
+func TestVLC_EnqueueDirectory(t *testing.T) {
+	t.Parallel()
+
+	t.Run("directory enqueued", func(t *testing.T) {
+		t.Parallel()
+
+		queue := newFakeQueue(t, "file:///media/a.mp3")
+
+		vlc := NewVLC(queue.client(t))
+
+		ids, err := vlc.EnqueueDirectory("file:///media", EnqueueDirectoryOptions{})
+		require.NoError(t, err)
+
+		// Only the media file is enqueued, and the already queued item is ignored
+		assert.Equal(t, []int{4}, ids)
+
+		require.Len(t, queue.enqueued, 1)
+		assert.Equal(t, inEnqueueCommand, queue.enqueued[0].Get(commandKey))
+		assert.Equal(t, "file:///media/a.mp3", queue.enqueued[0].Get(inputKey))
+	})
+
+	t.Run("recursive, played first", func(t *testing.T) {
+		t.Parallel()
+
+		queue := newFakeQueue(t)
+
+		vlc := NewVLC(queue.client(t))
+
+		// The back symlink is followed once, before the loop is detected
+		ids, err := vlc.EnqueueDirectory("file:///media/movies", EnqueueDirectoryOptions{
+			Recursive:  true,
+			MaxDepth:   2,
+			Play:       true,
+			Descending: true,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, []int{3, 4, 5}, ids)
+
+		require.Len(t, queue.enqueued, 3)
+		assert.Equal(t, inPlayCommand, queue.enqueued[0].Get(commandKey))
+		assert.Equal(t, "file:///media/movies/deep/c.mp4", queue.enqueued[0].Get(inputKey))
+		assert.Equal(t, inEnqueueCommand, queue.enqueued[1].Get(commandKey))
+		assert.Equal(t, "file:///media/movies/back/b.mkv", queue.enqueued[1].Get(inputKey))
+		assert.Equal(t, "file:///media/movies/b.mkv", queue.enqueued[2].Get(inputKey))
+	})
+
+	t.Run("media type filter", func(t *testing.T) {
+		t.Parallel()
+
+		queue := newFakeQueue(t)
+
+		vlc := NewVLC(queue.client(t))
+
+		ids, err := vlc.EnqueueDirectory("file:///media", EnqueueDirectoryOptions{
+			MediaTypes: []MediaType{MediaVideo},
+		})
+		require.NoError(t, err)
+
+		assert.Empty(t, ids)
+		assert.Empty(t, queue.enqueued)
+	})
+
+	t.Run("listing error", func(t *testing.T) {
+		t.Parallel()
+
+		queue := newFakeQueue(t)
+
+		vlc := NewVLC(queue.client(t))
+
+		ids, err := vlc.EnqueueDirectory("file:///media", EnqueueDirectoryOptions{Recursive: true})
+
+		assert.Nil(t, ids)
+		assert.ErrorIs(t, err, errTestListing)
+		assert.Empty(t, queue.enqueued)
+	})
+
+	t.Run("enqueue error", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			enqueueErr = errors.New("enqueue error")
+			queue      = newFakeQueue(t)
+			client     = queue.client(t)
+		)
+
+		vlc := NewVLC(&mockClient{
+			getContextFn: func(ctx context.Context, endpoint string) ([]byte, error) {
+				if strings.Contains(endpoint, inEnqueueCommand) {
+					return nil, enqueueErr
+				}
+
+				return client.GetContext(ctx, endpoint)
+			},
+		})
+
+		ids, err := vlc.EnqueueDirectory("file:///media", EnqueueDirectoryOptions{})
+
+		assert.Nil(t, ids)
+		assert.ErrorIs(t, err, enqueueErr)
+	})
+}
@@ -0,0 +1,216 @@
+package vlc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var errInvalidKeyAction = errors.New("invalid key action")
+
+// keyActionPrefix is the prefix of the hotkey option names (key-play, key-stop...).
+// VLC adds it to the key command value
+const keyActionPrefix = "key-"
+
+// maxBookmarks is the number of playlist bookmark slots
+const maxBookmarks = 10
+
+// KeyAction is a VLC hotkey action, triggered with PressKey
+type KeyAction string
+
+const (
+	// Playback //
+	KeyPlay        KeyAction = "play"
+	KeyPause       KeyAction = "pause"
+	KeyPlayPause   KeyAction = "play-pause"
+	KeyStop        KeyAction = "stop"
+	KeyNext        KeyAction = "next"
+	KeyPrev        KeyAction = "prev"
+	KeyFaster      KeyAction = "faster"
+	KeySlower      KeyAction = "slower"
+	KeyRateNormal  KeyAction = "rate-normal"
+	KeyRateFaster  KeyAction = "rate-faster-fine"
+	KeyRateSlower  KeyAction = "rate-slower-fine"
+	KeyFrameNext   KeyAction = "frame-next"
+	KeyRecord      KeyAction = "record"
+	KeyLoop        KeyAction = "loop"
+	KeyRandom      KeyAction = "random"
+	KeyPosition    KeyAction = "position"
+	KeySnapshot    KeyAction = "snapshot"
+	KeyClear       KeyAction = "clear-playlist"
+	KeyQuit        KeyAction = "quit"
+	KeyProgramNext KeyAction = "program-sid-next"
+	KeyProgramPrev KeyAction = "program-sid-prev"
+
+	// Jumps //
+	KeyJumpBackExtraShort KeyAction = "jump-extrashort"
+	KeyJumpExtraShort     KeyAction = "jump+extrashort"
+	KeyJumpBackShort      KeyAction = "jump-short"
+	KeyJumpShort          KeyAction = "jump+short"
+	KeyJumpBackMedium     KeyAction = "jump-medium"
+	KeyJumpMedium         KeyAction = "jump+medium"
+	KeyJumpBackLong       KeyAction = "jump-long"
+	KeyJumpLong           KeyAction = "jump+long"
+
+	// Titles, chapters and disc navigation //
+	KeyTitleNext   KeyAction = "title-next"
+	KeyTitlePrev   KeyAction = "title-prev"
+	KeyChapterNext KeyAction = "chapter-next"
+	KeyChapterPrev KeyAction = "chapter-prev"
+	KeyDiscMenu    KeyAction = "disc-menu"
+	KeyNavActivate KeyAction = "nav-activate"
+	KeyNavUp       KeyAction = "nav-up"
+	KeyNavDown     KeyAction = "nav-down"
+	KeyNavLeft     KeyAction = "nav-left"
+	KeyNavRight    KeyAction = "nav-right"
+
+	// Audio //
+	KeyVolumeUp         KeyAction = "vol-up"
+	KeyVolumeDown       KeyAction = "vol-down"
+	KeyVolumeMute       KeyAction = "vol-mute"
+	KeyAudioTrack       KeyAction = "audio-track"
+	KeyAudioDelayUp     KeyAction = "audiodelay-up"
+	KeyAudioDelayDown   KeyAction = "audiodelay-down"
+	KeyAudioDeviceCycle KeyAction = "audiodevice-cycle"
+
+	// Subtitles //
+	KeySubtitleTrack        KeyAction = "subtitle-track"
+	KeySubtitleRevTrack     KeyAction = "subtitle-revtrack"
+	KeySubtitleToggle       KeyAction = "subtitle-toggle"
+	KeySubtitleDelayUp      KeyAction = "subdelay-up"
+	KeySubtitleDelayDown    KeyAction = "subdelay-down"
+	KeySubtitlePositionUp   KeyAction = "subpos-up"
+	KeySubtitlePositionDown KeyAction = "subpos-down"
+	KeySubtitleScaleUp      KeyAction = "subtitle-text-scale-up"
+	KeySubtitleScaleDown    KeyAction = "subtitle-text-scale-down"
+	KeySubtitleScaleNormal  KeyAction = "subtitle-text-scale-normal"
+	KeySubsyncMarkAudio     KeyAction = "subsync-markaudio"
+	KeySubsyncMarkSubtitle  KeyAction = "subsync-marksub"
+	KeySubsyncApply         KeyAction = "subsync-apply"
+	KeySubsyncReset         KeyAction = "subsync-reset"
+
+	// Video //
+	KeyToggleFullscreen KeyAction = "toggle-fullscreen"
+	KeyLeaveFullscreen  KeyAction = "leave-fullscreen"
+	KeyAspectRatio      KeyAction = "aspect-ratio"
+	KeyCrop             KeyAction = "crop"
+	KeyCropTop          KeyAction = "crop-top"
+	KeyUncropTop        KeyAction = "uncrop-top"
+	KeyCropLeft         KeyAction = "crop-left"
+	KeyUncropLeft       KeyAction = "uncrop-left"
+	KeyCropBottom       KeyAction = "crop-bottom"
+	KeyUncropBottom     KeyAction = "uncrop-bottom"
+	KeyCropRight        KeyAction = "crop-right"
+	KeyUncropRight      KeyAction = "uncrop-right"
+	KeyDeinterlace      KeyAction = "deinterlace"
+	KeyDeinterlaceMode  KeyAction = "deinterlace-mode"
+	KeyToggleAutoscale  KeyAction = "toggle-autoscale"
+	KeyIncrScaleFactor  KeyAction = "incr-scalefactor"
+	KeyDecrScaleFactor  KeyAction = "decr-scalefactor"
+	KeyZoom             KeyAction = "zoom"
+	KeyUnzoom           KeyAction = "unzoom"
+	KeyZoomQuarter      KeyAction = "zoom-quarter"
+	KeyZoomHalf         KeyAction = "zoom-half"
+	KeyZoomOriginal     KeyAction = "zoom-original"
+	KeyZoomDouble       KeyAction = "zoom-double"
+	KeyWallpaper        KeyAction = "wallpaper"
+
+	// 360° viewpoint //
+	KeyViewpointFOVIn         KeyAction = "viewpoint-fov-in"
+	KeyViewpointFOVOut        KeyAction = "viewpoint-fov-out"
+	KeyViewpointRollClock     KeyAction = "viewpoint-roll-clock"
+	KeyViewpointRollAntiClock KeyAction = "viewpoint-roll-anticlock"
+
+	// Interface //
+	KeyIntfShow      KeyAction = "intf-show"
+	KeyIntfBoss      KeyAction = "intf-boss"
+	KeyIntfPopupMenu KeyAction = "intf-popup-menu"
+)
+
+// keyActions are the VLC 3 hotkey actions, apart from the bookmark ones
+var keyActions = map[KeyAction]struct{}{
+	KeyPlay: {}, KeyPause: {}, KeyPlayPause: {}, KeyStop: {}, KeyNext: {}, KeyPrev: {},
+	KeyFaster: {}, KeySlower: {}, KeyRateNormal: {}, KeyRateFaster: {}, KeyRateSlower: {},
+	KeyFrameNext: {}, KeyRecord: {}, KeyLoop: {}, KeyRandom: {}, KeyPosition: {},
+	KeySnapshot: {}, KeyClear: {}, KeyQuit: {}, KeyProgramNext: {}, KeyProgramPrev: {},
+
+	KeyJumpBackExtraShort: {}, KeyJumpExtraShort: {}, KeyJumpBackShort: {}, KeyJumpShort: {},
+	KeyJumpBackMedium: {}, KeyJumpMedium: {}, KeyJumpBackLong: {}, KeyJumpLong: {},
+
+	KeyTitleNext: {}, KeyTitlePrev: {}, KeyChapterNext: {}, KeyChapterPrev: {}, KeyDiscMenu: {},
+	KeyNavActivate: {}, KeyNavUp: {}, KeyNavDown: {}, KeyNavLeft: {}, KeyNavRight: {},
+
+	KeyVolumeUp: {}, KeyVolumeDown: {}, KeyVolumeMute: {}, KeyAudioTrack: {},
+	KeyAudioDelayUp: {}, KeyAudioDelayDown: {}, KeyAudioDeviceCycle: {},
+	KeySubtitleTrack: {}, KeySubtitleRevTrack: {}, KeySubtitleToggle: {},
+	KeySubtitleDelayUp: {}, KeySubtitleDelayDown: {},
+
+	KeySubtitlePositionUp: {}, KeySubtitlePositionDown: {},
+	KeySubtitleScaleUp: {}, KeySubtitleScaleDown: {}, KeySubtitleScaleNormal: {},
+	KeySubsyncMarkAudio: {}, KeySubsyncMarkSubtitle: {}, KeySubsyncApply: {}, KeySubsyncReset: {},
+
+	KeyToggleFullscreen: {}, KeyLeaveFullscreen: {}, KeyAspectRatio: {}, KeyCrop: {},
+	KeyCropTop: {}, KeyUncropTop: {}, KeyCropLeft: {}, KeyUncropLeft: {},
+	KeyCropBottom: {}, KeyUncropBottom: {}, KeyCropRight: {}, KeyUncropRight: {},
+	KeyDeinterlace: {}, KeyDeinterlaceMode: {}, KeyToggleAutoscale: {},
+	KeyIncrScaleFactor: {}, KeyDecrScaleFactor: {}, KeyZoom: {}, KeyUnzoom: {},
+	KeyZoomQuarter: {}, KeyZoomHalf: {}, KeyZoomOriginal: {}, KeyZoomDouble: {}, KeyWallpaper: {},
+
+	KeyViewpointFOVIn: {}, KeyViewpointFOVOut: {}, KeyViewpointRollClock: {}, KeyViewpointRollAntiClock: {},
+
+	KeyIntfShow: {}, KeyIntfBoss: {}, KeyIntfPopupMenu: {},
+}
+
+// KeyPlayBookmark returns the action that plays the given playlist bookmark [1-10]
+func KeyPlayBookmark(slot int) KeyAction {
+	return KeyAction(fmt.Sprintf("play-bookmark%d", slot))
+}
+
+// KeySetBookmark returns the action that sets the given playlist bookmark [1-10]
+func KeySetBookmark(slot int) KeyAction {
+	return KeyAction(fmt.Sprintf("set-bookmark%d", slot))
+}
+
+// String returns the VLC hotkey option name of the action (key-play, key-stop...)
+func (a KeyAction) String() string {
+	return keyActionPrefix + string(a)
+}
+
+// IsValid checks if the action is a known VLC 3 hotkey action
+func (a KeyAction) IsValid() bool {
+	if _, ok := keyActions[a]; ok {
+		return true
+	}
+
+	for slot := 1; slot <= maxBookmarks; slot++ {
+		if a == KeyPlayBookmark(slot) || a == KeySetBookmark(slot) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// PressKey triggers the given hotkey action, as if its key was pressed.
+// The action can also be given as the full hotkey option name (key-play)
+func (v *VLC) PressKey(action KeyAction) (*Status, error) {
+	return v.PressKeyContext(context.Background(), action)
+}
+
+// PressKeyContext is PressKey with a context that controls the request lifetime
+func (v *VLC) PressKeyContext(ctx context.Context, action KeyAction) (*Status, error) {
+	// VLC adds the prefix itself
+	action = KeyAction(strings.TrimPrefix(string(action), keyActionPrefix))
+
+	if !action.IsValid() {
+		return nil, fmt.Errorf("%w, %q", errInvalidKeyAction, string(action))
+	}
+
+	params := paramMap{
+		commandKey: keyCommand,
+		valKey:     string(action),
+	}
+
+	return v.executeStatusRequest(ctx, params)
+}
@@ -0,0 +1,112 @@
+package vlc
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyAction_IsValid(t *testing.T) {
+	t.Parallel()
+
+	testTable := []struct {
+		name   string
+		action KeyAction
+		valid  bool
+	}{
+		{"playback action", KeyPlayPause, true},
+		{"jump action", KeyJumpShort, true},
+		{"play bookmark", KeyPlayBookmark(10), true},
+		{"set bookmark", KeySetBookmark(1), true},
+		{"bookmark out of range", KeyPlayBookmark(11), false},
+		{"prefixed action", KeyAction("key-play"), false},
+		{"unknown action", KeyAction("self-destruct"), false},
+		{"empty action", KeyAction(""), false},
+	}
+
+	for _, testCase := range testTable {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, testCase.valid, testCase.action.IsValid())
+		})
+	}
+}
+
+func TestKeyAction_String(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "key-jump+short", KeyJumpShort.String())
+	assert.Equal(t, "key-set-bookmark3", KeySetBookmark(3).String())
+}
+
+func TestVLC_PressKey(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid key action", func(t *testing.T) {
+		t.Parallel()
+
+		mockClient := &mockClient{
+			getFn: func(_ string) ([]byte, error) {
+				t.Fatal("request executed for an invalid action")
+
+				return nil, nil
+			},
+		}
+
+		vlc := NewVLC(mockClient)
+
+		status, err := vlc.PressKey("self-destruct")
+
+		assert.Nil(t, status)
+		assert.ErrorIs(t, err, errInvalidKeyAction)
+	})
+
+	testTable := []struct {
+		name          string
+		action        KeyAction
+		expectedValue string
+	}{
+		{"key action", KeyJumpShort, "jump+short"},
+		{"prefixed key action", KeyAction("key-disc-menu"), "disc-menu"},
+		{"bookmark key action", KeyPlayBookmark(2), "play-bookmark2"},
+	}
+
+	for _, testCase := range testTable {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				expectedParams = paramMap{
+					commandKey: keyCommand,
+					valKey:     testCase.expectedValue,
+				}
+
+				mockClient = &mockClient{
+					getFn: func(endpoint string) ([]byte, error) {
+						require.Equal(
+							t,
+							buildQueryEndpoint(baseStatus, expectedParams),
+							endpoint,
+						)
+
+						return json.Marshal(&Status{})
+					},
+				}
+			)
+
+			vlc := NewVLC(mockClient)
+
+			status, err := vlc.PressKey(testCase.action)
+			require.NoError(t, err)
+
+			assert.NotNil(t, status)
+		})
+	}
+}
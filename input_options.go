@@ -0,0 +1,185 @@
+package vlc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/zivkovicmilos/go-vlc/mrl"
+)
+
+var errInvalidInputOption = errors.New("invalid input option")
+
+// InputOptions are the VLC input options of a played or enqueued item.
+// Zero values are not sent, so VLC uses its defaults
+type InputOptions struct {
+	AudioTrack     *int          // :audio-track, the audio track index (starting from 0)
+	SubtitleFile   string        // :sub-file, the path of an external subtitle file
+	Raw            []string      // raw options, such as ":avcodec-hw=none" (the leading colon is optional)
+	StartTime      time.Duration // :start-time
+	StopTime       time.Duration // :stop-time
+	ImageDuration  time.Duration // :image-duration, negative for unlimited
+	NetworkCaching time.Duration // :network-caching, with millisecond precision
+	InputRepeat    int           // :input-repeat, the number of times the input is repeated
+	NoAudio        bool          // :no-audio
+	NoVideo        bool          // :no-video
+}
+
+// validate checks if the options are within the VLC ranges
+func (o *InputOptions) validate() error {
+	switch {
+	case o.AudioTrack != nil && *o.AudioTrack < 0:
+		return fmt.Errorf("%w, negative audio track, %d", errInvalidInputOption, *o.AudioTrack)
+	case o.StartTime < 0:
+		return fmt.Errorf("%w, negative start time, %s", errInvalidInputOption, o.StartTime)
+	case o.StopTime < 0:
+		return fmt.Errorf("%w, negative stop time, %s", errInvalidInputOption, o.StopTime)
+	case o.StopTime != 0 && o.StopTime <= o.StartTime:
+		return fmt.Errorf("%w, stop time %s before start time %s", errInvalidInputOption, o.StopTime, o.StartTime)
+	case o.NetworkCaching < 0:
+		return fmt.Errorf("%w, negative network caching, %s", errInvalidInputOption, o.NetworkCaching)
+	case o.InputRepeat < 0:
+		return fmt.Errorf("%w, negative input repeat, %d", errInvalidInputOption, o.InputRepeat)
+	default:
+		return nil
+	}
+}
+
+// options returns the options, in the VLC ":name=value" format
+func (o *InputOptions) options() []string {
+	options := make([]string, 0)
+
+	add := func(name, value string) {
+		options = append(options, ":"+name+"="+value)
+	}
+
+	if o.StartTime > 0 {
+		add("start-time", formatSeconds(o.StartTime))
+	}
+
+	if o.StopTime > 0 {
+		add("stop-time", formatSeconds(o.StopTime))
+	}
+
+	if o.InputRepeat > 0 {
+		add("input-repeat", strconv.Itoa(o.InputRepeat))
+	}
+
+	if o.SubtitleFile != "" {
+		add("sub-file", o.SubtitleFile)
+	}
+
+	if o.AudioTrack != nil {
+		add("audio-track", strconv.Itoa(*o.AudioTrack))
+	}
+
+	switch {
+	case o.ImageDuration < 0:
+		add("image-duration", "-1")
+	case o.ImageDuration > 0:
+		add("image-duration", formatSeconds(o.ImageDuration))
+	}
+
+	if o.NetworkCaching > 0 {
+		add("network-caching", strconv.FormatInt(o.NetworkCaching.Milliseconds(), 10))
+	}
+
+	if o.NoAudio {
+		options = append(options, ":no-audio")
+	}
+
+	if o.NoVideo {
+		options = append(options, ":no-video")
+	}
+
+	return append(options, o.Raw...)
+}
+
+// formatSeconds formats the duration as (fractional) seconds
+func formatSeconds(d time.Duration) string {
+	return strconv.FormatFloat(d.Seconds(), 'f', -1, 64)
+}
+
+// inputParams creates the query parameters for the input command, with the options repeated
+func inputParams(command, source string, opts InputOptions) (multiParamMap, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	params := multiParamMap{
+		commandKey: {command},
+		inputKey:   {source},
+	}
+
+	if options := opts.options(); len(options) > 0 {
+		params[optionKey] = options
+	}
+
+	return params, nil
+}
+
+// PlaySourceWithOptions plays a source (URI), with the given input options
+func (v *VLC) PlaySourceWithOptions(source string, opts InputOptions) (*Status, error) {
+	return v.PlaySourceWithOptionsContext(context.Background(), source, opts)
+}
+
+// PlaySourceWithOptionsContext is PlaySourceWithOptions with a context that controls the request lifetime
+func (v *VLC) PlaySourceWithOptionsContext(ctx context.Context, source string, opts InputOptions) (*Status, error) {
+	params, err := inputParams(inPlayCommand, source, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.executeStatusRequest(ctx, params)
+}
+
+// AddToPlaylistWithOptions adds a source (URI) to the playlist, with the given input options
+func (v *VLC) AddToPlaylistWithOptions(source string, opts InputOptions) (*Status, error) {
+	return v.AddToPlaylistWithOptionsContext(context.Background(), source, opts)
+}
+
+// AddToPlaylistWithOptionsContext is AddToPlaylistWithOptions with a context that controls the request lifetime
+func (v *VLC) AddToPlaylistWithOptionsContext(
+	ctx context.Context,
+	source string,
+	opts InputOptions,
+) (*Status, error) {
+	params, err := inputParams(inEnqueueCommand, source, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.executeStatusRequest(ctx, params)
+}
+
+// withMRLOptions returns the options, with the per-item MRL options appended to the raw ones
+func (o InputOptions) withMRLOptions(m *mrl.MRL) InputOptions {
+	raw := make([]string, 0, len(o.Raw)+len(m.Options))
+	raw = append(raw, o.Raw...)
+
+	o.Raw = append(raw, m.Options...)
+
+	return o
+}
+
+// PlayMRL plays the MRL, with its per-item options added to the given input options
+func (v *VLC) PlayMRL(m *mrl.MRL, opts InputOptions) (*Status, error) {
+	return v.PlayMRLContext(context.Background(), m, opts)
+}
+
+// PlayMRLContext is PlayMRL with a context that controls the request lifetime
+func (v *VLC) PlayMRLContext(ctx context.Context, m *mrl.MRL, opts InputOptions) (*Status, error) {
+	return v.PlaySourceWithOptionsContext(ctx, m.String(), opts.withMRLOptions(m))
+}
+
+// AddMRLToPlaylist adds the MRL to the playlist, with its per-item options added to the given input options
+func (v *VLC) AddMRLToPlaylist(m *mrl.MRL, opts InputOptions) (*Status, error) {
+	return v.AddMRLToPlaylistContext(context.Background(), m, opts)
+}
+
+// AddMRLToPlaylistContext is AddMRLToPlaylist with a context that controls the request lifetime
+func (v *VLC) AddMRLToPlaylistContext(ctx context.Context, m *mrl.MRL, opts InputOptions) (*Status, error) {
+	return v.AddToPlaylistWithOptionsContext(ctx, m.String(), opts.withMRLOptions(m))
+}
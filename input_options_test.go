@@ -0,0 +1,275 @@
+package vlc
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zivkovicmilos/go-vlc/mrl"
+)
+
+func TestInputOptions_Options(t *testing.T) {
+	t.Parallel()
+
+	audioTrack := 0
+
+	testTable := []struct {
+		name            string
+		expectedOptions []string
+		opts            InputOptions
+	}{
+		{
+			"no options",
+			[]string{},
+			InputOptions{},
+		},
+		{
+			"all options",
+			[]string{
+				":start-time=10.5",
+				":stop-time=90",
+				":input-repeat=2",
+				":sub-file=/media/movie.srt",
+				":audio-track=0",
+				":image-duration=-1",
+				":network-caching=1500",
+				":no-audio",
+				":no-video",
+				":avcodec-hw=none",
+			},
+			InputOptions{
+				StartTime:      10*time.Second + 500*time.Millisecond,
+				StopTime:       90 * time.Second,
+				InputRepeat:    2,
+				SubtitleFile:   "/media/movie.srt",
+				AudioTrack:     &audioTrack,
+				ImageDuration:  -1,
+				NetworkCaching: 1500 * time.Millisecond,
+				NoAudio:        true,
+				NoVideo:        true,
+				Raw:            []string{":avcodec-hw=none"},
+			},
+		},
+		{
+			"image duration",
+			[]string{":image-duration=5"},
+			InputOptions{ImageDuration: 5 * time.Second},
+		},
+	}
+
+	for _, testCase := range testTable {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.NoError(t, testCase.opts.validate())
+
+			assert.Equal(t, testCase.expectedOptions, testCase.opts.options())
+		})
+	}
+}
+
+func TestInputOptions_Validate(t *testing.T) {
+	t.Parallel()
+
+	audioTrack := -1
+
+	testTable := []struct {
+		name string
+		opts InputOptions
+	}{
+		{"negative audio track", InputOptions{AudioTrack: &audioTrack}},
+		{"negative start time", InputOptions{StartTime: -time.Second}},
+		{"negative stop time", InputOptions{StopTime: -time.Second}},
+		{"stop time before start time", InputOptions{StartTime: time.Minute, StopTime: time.Second}},
+		{"negative network caching", InputOptions{NetworkCaching: -time.Second}},
+		{"negative input repeat", InputOptions{InputRepeat: -1}},
+	}
+
+	for _, testCase := range testTable {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.ErrorIs(t, testCase.opts.validate(), errInvalidInputOption)
+		})
+	}
+}
+
+func TestVLC_PlaySourceWithOptions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid options", func(t *testing.T) {
+		t.Parallel()
+
+		vlc := NewVLC(&mockClient{
+			getFn: func(string) ([]byte, error) {
+				t.Error("request sent with invalid options")
+
+				return nil, nil
+			},
+		})
+
+		status, err := vlc.PlaySourceWithOptions("source", InputOptions{InputRepeat: -1})
+
+		assert.Nil(t, status)
+		assert.ErrorIs(t, err, errInvalidInputOption)
+	})
+
+	t.Run("source played with options", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			expectedStatus = &Status{
+				Version: "random version",
+				State:   "playing",
+			}
+
+			source = "file:///media/movie.mkv"
+
+			expectedParams = multiParamMap{
+				commandKey: {inPlayCommand},
+				inputKey:   {source},
+				optionKey:  {":start-time=30", ":no-video"},
+			}
+
+			mockClient = &mockClient{
+				getFn: func(endpoint string) ([]byte, error) {
+					require.Equal(
+						t,
+						buildQueryEndpoint(baseStatus, expectedParams),
+						endpoint,
+					)
+
+					return json.Marshal(expectedStatus)
+				},
+			}
+		)
+
+		vlc := NewVLC(mockClient)
+
+		status, err := vlc.PlaySourceWithOptions(source, InputOptions{
+			StartTime: 30 * time.Second,
+			NoVideo:   true,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, expectedStatus, status)
+	})
+}
+
+func TestVLC_AddToPlaylistWithOptions(t *testing.T) {
+	t.Parallel()
+
+	var (
+		expectedStatus = &Status{
+			Version: "random version",
+			State:   "playing",
+		}
+
+		source = "file:///media/image.png"
+
+		expectedParams = multiParamMap{
+			commandKey: {inEnqueueCommand},
+			inputKey:   {source},
+			optionKey:  {":image-duration=15", "no-audio"},
+		}
+
+		mockClient = &mockClient{
+			getFn: func(endpoint string) ([]byte, error) {
+				require.Equal(
+					t,
+					buildQueryEndpoint(baseStatus, expectedParams),
+					endpoint,
+				)
+
+				return json.Marshal(expectedStatus)
+			},
+		}
+	)
+
+	vlc := NewVLC(mockClient)
+
+	status, err := vlc.AddToPlaylistWithOptions(source, InputOptions{
+		ImageDuration: 15 * time.Second,
+		Raw:           []string{"no-audio"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, expectedStatus, status)
+}
+
+func TestVLC_PlayMRL(t *testing.T) {
+	t.Parallel()
+
+	m, err := mrl.Parse("dvd:///dev/sr0#2:3 :dvdnav-menu=0 :no-video")
+	require.NoError(t, err)
+
+	var (
+		expectedParams = multiParamMap{
+			commandKey: {inPlayCommand},
+			inputKey:   {"dvd:///dev/sr0#2:3"},
+			optionKey:  {":start-time=5", ":avcodec-hw=none", ":dvdnav-menu=0", ":no-video"},
+		}
+
+		mockClient = &mockClient{
+			getFn: func(endpoint string) ([]byte, error) {
+				require.Equal(
+					t,
+					buildQueryEndpoint(baseStatus, expectedParams),
+					endpoint,
+				)
+
+				return json.Marshal(&Status{})
+			},
+		}
+
+		opts = InputOptions{
+			StartTime: 5 * time.Second,
+			Raw:       []string{":avcodec-hw=none"},
+		}
+	)
+
+	vlc := NewVLC(mockClient)
+
+	_, err = vlc.PlayMRL(m, opts)
+	require.NoError(t, err)
+
+	// Make sure the given options are not modified
+	assert.Equal(t, []string{":avcodec-hw=none"}, opts.Raw)
+}
+
+func TestVLC_AddMRLToPlaylist(t *testing.T) {
+	t.Parallel()
+
+	var (
+		m = mrl.File("/media/movie.mkv").WithOption("sub-file", "/media/movie.srt")
+
+		expectedParams = multiParamMap{
+			commandKey: {inEnqueueCommand},
+			inputKey:   {"file:///media/movie.mkv"},
+			optionKey:  {":sub-file=/media/movie.srt"},
+		}
+
+		mockClient = &mockClient{
+			getFn: func(endpoint string) ([]byte, error) {
+				require.Equal(
+					t,
+					buildQueryEndpoint(baseStatus, expectedParams),
+					endpoint,
+				)
+
+				return json.Marshal(&Status{})
+			},
+		}
+	)
+
+	vlc := NewVLC(mockClient)
+
+	_, err := vlc.AddMRLToPlaylist(m, InputOptions{})
+	require.NoError(t, err)
+}
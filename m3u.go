@@ -0,0 +1,262 @@
+package vlc
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/zivkovicmilos/go-vlc/mrl"
+)
+
+var errInvalidBaseURI = errors.New("invalid base URI")
+
+const (
+	m3uHeader          = "#EXTM3U"
+	m3uInfoTag         = "#EXTINF:"
+	m3uUTF8BOM         = "\uFEFF"
+	unknownM3UDuration = -1
+)
+
+var (
+	// schemeRegex matches locations with a URI scheme, such as http:// or file://
+	schemeRegex = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9+.-]*://`)
+
+	// windowsPathRegex matches absolute Windows paths, such as C:\Music
+	windowsPathRegex = regexp.MustCompile(`^[A-Za-z]:[\\/]`)
+)
+
+// M3UEntry is a single extended M3U playlist entry
+type M3UEntry struct {
+	Location string // the URI, or the (relative) path
+	Title    string
+	Duration int64 // seconds, -1 if unknown
+}
+
+// M3UEntryError is a failure to enqueue a single M3U entry
+type M3UEntryError struct {
+	Err   error
+	Entry M3UEntry
+}
+
+// Error returns the string representation of the entry error
+func (e *M3UEntryError) Error() string {
+	return fmt.Sprintf("unable to enqueue %s, %s", e.Entry.Location, e.Err)
+}
+
+// Unwrap returns the underlying enqueue error
+func (e *M3UEntryError) Unwrap() error {
+	return e.Err
+}
+
+// M3UImportResult is the outcome of an M3U import
+type M3UImportResult struct {
+	Enqueued []M3UEntry      // the entries enqueued, with resolved locations
+	Failed   []M3UEntryError // the entries that couldn't be resolved or enqueued
+}
+
+// ParseM3U parses an M3U / M3U8 playlist, with optional #EXTINF information.
+// Other directives and comments are skipped
+func ParseM3U(r io.Reader) ([]M3UEntry, error) {
+	var (
+		entries = make([]M3UEntry, 0)
+		scanner = bufio.NewScanner(r)
+
+		// info is the #EXTINF information for the next entry
+		info *M3UEntry
+	)
+
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+
+		if lineNumber == 1 {
+			line = strings.TrimPrefix(line, m3uUTF8BOM)
+		}
+
+		switch {
+		case line == "":
+			continue
+		case strings.HasPrefix(line, m3uInfoTag):
+			info = parseM3UInfo(strings.TrimPrefix(line, m3uInfoTag))
+		case strings.HasPrefix(line, "#"):
+			continue
+		default:
+			entry := M3UEntry{
+				Location: line,
+				Duration: unknownM3UDuration,
+			}
+
+			if info != nil {
+				entry.Title = info.Title
+				entry.Duration = info.Duration
+				info = nil
+			}
+
+			entries = append(entries, entry)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("unable to read M3U, %w", err)
+	}
+
+	return entries, nil
+}
+
+// parseM3UInfo parses the #EXTINF:<duration>[ attributes],<title> information.
+// Missing or unparsable durations are unknown, and the title is kept
+func parseM3UInfo(info string) *M3UEntry {
+	rawDuration, title, _ := strings.Cut(info, ",")
+
+	// Extended attributes (tvg-id="..." etc.) can follow the duration
+	rawDuration, _, _ = strings.Cut(strings.TrimSpace(rawDuration), " ")
+
+	duration, err := strconv.ParseFloat(rawDuration, 64)
+	if err != nil {
+		duration = unknownM3UDuration
+	}
+
+	return &M3UEntry{
+		Title:    strings.TrimSpace(title),
+		Duration: int64(duration),
+	}
+}
+
+// WriteM3U writes the playlist leaves as an extended M3U playlist.
+// Only the play queue ("Playlist" node) is written, if the playlist is the full tree
+func WriteM3U(w io.Writer, playlist *Playlist) error {
+	var (
+		buf   = bufio.NewWriter(w)
+		queue = playlist
+	)
+
+	if node := playlist.PlaylistNode(); node != nil {
+		queue = node
+	}
+
+	if _, err := buf.WriteString(m3uHeader + "\n"); err != nil {
+		return fmt.Errorf("unable to write M3U, %w", err)
+	}
+
+	for _, item := range queue.Leaves() {
+		duration := item.Duration
+		if duration <= 0 {
+			duration = unknownM3UDuration
+		}
+
+		// Titles are single line
+		title := strings.NewReplacer("\r", " ", "\n", " ").Replace(item.Name)
+
+		if _, err := fmt.Fprintf(buf, "%s%d,%s\n%s\n", m3uInfoTag, duration, title, item.URI); err != nil {
+			return fmt.Errorf("unable to write M3U, %w", err)
+		}
+	}
+
+	if err := buf.Flush(); err != nil {
+		return fmt.Errorf("unable to write M3U, %w", err)
+	}
+
+	return nil
+}
+
+// ExportM3U writes the current playlist as an extended M3U playlist
+func (v *VLC) ExportM3U(w io.Writer) error {
+	return v.ExportM3UContext(context.Background(), w)
+}
+
+// ExportM3UContext is ExportM3U with a context that controls the request lifetime
+func (v *VLC) ExportM3UContext(ctx context.Context, w io.Writer) error {
+	playlist, err := v.GetPlaylistContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	return WriteM3U(w, playlist)
+}
+
+// ImportM3U parses the M3U playlist, and enqueues every entry.
+// Relative paths are resolved against the base URI (for example, file:///home/user/playlists/),
+// which can be empty if all the entries are absolute.
+//
+// Entries that can't be resolved or enqueued are reported in the result, and don't stop the import
+func (v *VLC) ImportM3U(r io.Reader, baseURI string) (*M3UImportResult, error) {
+	return v.ImportM3UContext(context.Background(), r, baseURI)
+}
+
+// ImportM3UContext is ImportM3U with a context that controls the request lifetime
+func (v *VLC) ImportM3UContext(ctx context.Context, r io.Reader, baseURI string) (*M3UImportResult, error) {
+	entries, err := ParseM3U(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var base *url.URL
+
+	if baseURI != "" {
+		if base, err = url.Parse(baseURI); err != nil {
+			return nil, fmt.Errorf("%w, %w", errInvalidBaseURI, err)
+		}
+	}
+
+	result := &M3UImportResult{
+		Enqueued: make([]M3UEntry, 0, len(entries)),
+		Failed:   make([]M3UEntryError, 0),
+	}
+
+	for _, entry := range entries {
+		// Stop early if the context is done, instead of failing every entry
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return result, ctxErr
+		}
+
+		location, resolveErr := resolveM3ULocation(base, entry.Location)
+		if resolveErr != nil {
+			result.Failed = append(result.Failed, M3UEntryError{Entry: entry, Err: resolveErr})
+
+			continue
+		}
+
+		entry.Location = location
+
+		if _, enqueueErr := v.AddToPlaylistContext(ctx, location); enqueueErr != nil {
+			result.Failed = append(result.Failed, M3UEntryError{Entry: entry, Err: enqueueErr})
+
+			continue
+		}
+
+		result.Enqueued = append(result.Enqueued, entry)
+	}
+
+	return result, nil
+}
+
+// resolveM3ULocation resolves the M3U entry location into an absolute URI
+func resolveM3ULocation(base *url.URL, location string) (string, error) {
+	// Absolute URIs are used as-is
+	if schemeRegex.MatchString(location) {
+		return location, nil
+	}
+
+	// Absolute Windows paths
+	if windowsPathRegex.MatchString(location) {
+		return mrl.File(location).String(), nil
+	}
+
+	path := strings.ReplaceAll(location, `\`, "/")
+
+	// Absolute POSIX paths
+	if strings.HasPrefix(path, "/") && base == nil {
+		return mrl.File(path).String(), nil
+	}
+
+	if base == nil {
+		return "", fmt.Errorf("%w, relative path without a base URI, %s", errInvalidBaseURI, location)
+	}
+
+	return base.ResolveReference(&url.URL{Path: path}).String(), nil
+}
@@ -0,0 +1,215 @@
+package vlc
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseM3U(t *testing.T) {
+	t.Parallel()
+
+	t.Run("extended playlist", func(t *testing.T) {
+		t.Parallel()
+
+		input := "\uFEFF#EXTM3U\n" +
+			"#EXTINF:123,Artist - Title\n" +
+			"music/track1.mp3\n" +
+			"\n" +
+			"# a comment\n" +
+			"#EXTVLCOPT:network-caching=1000\n" +
+			"#EXTINF:-1 tvg-id=\"news\",News\r\n" +
+			"http://example.com/live.m3u8\r\n" +
+			"/media/plain.mp4\n"
+
+		entries, err := ParseM3U(strings.NewReader(input))
+		require.NoError(t, err)
+
+		assert.Equal(t, []M3UEntry{
+			{Location: "music/track1.mp3", Title: "Artist - Title", Duration: 123},
+			{Location: "http://example.com/live.m3u8", Title: "News", Duration: -1},
+			{Location: "/media/plain.mp4", Duration: -1},
+		}, entries)
+	})
+
+	t.Run("invalid duration", func(t *testing.T) {
+		t.Parallel()
+
+		input := "#EXTM3U\n" +
+			"#EXTINF:abc,Title\n" +
+			"file.mp3\n" +
+			"#EXTINF:,Untimed\n" +
+			"other.mp3\n"
+
+		entries, err := ParseM3U(strings.NewReader(input))
+		require.NoError(t, err)
+
+		assert.Equal(t, []M3UEntry{
+			{Location: "file.mp3", Title: "Title", Duration: -1},
+			{Location: "other.mp3", Title: "Untimed", Duration: -1},
+		}, entries)
+	})
+}
+
+func TestWriteM3U(t *testing.T) {
+	t.Parallel()
+
+	tree := newTestTree()
+	tree.Children[0].Children[0].Duration = 42
+
+	var buf bytes.Buffer
+
+	require.NoError(t, WriteM3U(&buf, tree))
+
+	// Only the play queue is exported, not the media library
+	assert.Equal(
+		t,
+		"#EXTM3U\n"+
+			"#EXTINF:42,intro\nfile:///media/intro.mp4\n"+
+			"#EXTINF:-1,track 1\nfile:///media/track1.mp3\n"+
+			"#EXTINF:-1,track 2\nfile:///media/track2.mp3\n"+
+			"#EXTINF:-1,intro\nfile:///media/intro.mp4\n",
+		buf.String(),
+	)
+
+	// The export can be imported back
+	entries, err := ParseM3U(&buf)
+	require.NoError(t, err)
+
+	require.Len(t, entries, 4)
+	assert.Equal(t, M3UEntry{Location: "file:///media/intro.mp4", Title: "intro", Duration: 42}, entries[0])
+}
+
+func TestVLC_ExportM3U(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unable to fetch playlist", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			fetchErr   = errors.New("fetch error")
+			mockClient = &mockClient{
+				getFn: func(string) ([]byte, error) {
+					return nil, fetchErr
+				},
+			}
+		)
+
+		vlc := NewVLC(mockClient)
+
+		assert.ErrorIs(t, vlc.ExportM3U(&bytes.Buffer{}), fetchErr)
+	})
+
+	t.Run("playlist exported", func(t *testing.T) {
+		t.Parallel()
+
+		mockClient := &mockClient{
+			getFn: func(endpoint string) ([]byte, error) {
+				require.Equal(t, basePlaylist, endpoint)
+
+				return json.Marshal(newTestTree())
+			},
+		}
+
+		vlc := NewVLC(mockClient)
+
+		var buf bytes.Buffer
+
+		require.NoError(t, vlc.ExportM3U(&buf))
+
+		assert.Equal(t, 9, strings.Count(buf.String(), "\n"))
+	})
+}
+
+func TestVLC_ImportM3U(t *testing.T) {
+	t.Parallel()
+
+	t.Run("entries resolved and enqueued", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			input = "#EXTM3U\n" +
+				"#EXTINF:10,First\n" +
+				"music/first song.mp3\n" +
+				"../other/second.mp3\n" +
+				"/media/third.mp3\n" +
+				"C:\\Music\\fourth.mp3\n" +
+				"http://example.com/fifth.mp3\n"
+
+			enqueueErr = errors.New("enqueue error")
+
+			enqueued = make([]string, 0)
+
+			mockClient = &mockClient{
+				getFn: func(endpoint string) ([]byte, error) {
+					parsed, err := url.Parse(endpoint)
+					require.NoError(t, err)
+
+					input := parsed.Query().Get(inputKey)
+					enqueued = append(enqueued, input)
+
+					if strings.HasPrefix(input, "http") {
+						return nil, enqueueErr
+					}
+
+					return json.Marshal(&Status{})
+				},
+			}
+		)
+
+		vlc := NewVLC(mockClient)
+
+		result, err := vlc.ImportM3U(strings.NewReader(input), "file:///home/user/playlists/")
+		require.NoError(t, err)
+
+		assert.Equal(t, []M3UEntry{
+			{Location: "file:///home/user/playlists/music/first%20song.mp3", Title: "First", Duration: 10},
+			{Location: "file:///home/user/other/second.mp3", Duration: -1},
+			{Location: "file:///media/third.mp3", Duration: -1},
+			{Location: "file:///C:/Music/fourth.mp3", Duration: -1},
+		}, result.Enqueued)
+
+		require.Len(t, result.Failed, 1)
+		assert.Equal(t, "http://example.com/fifth.mp3", result.Failed[0].Entry.Location)
+		assert.ErrorIs(t, &result.Failed[0], enqueueErr)
+
+		assert.Len(t, enqueued, 5)
+	})
+
+	t.Run("relative path without base URI", func(t *testing.T) {
+		t.Parallel()
+
+		mockClient := &mockClient{
+			getFn: func(string) ([]byte, error) {
+				return json.Marshal(&Status{})
+			},
+		}
+
+		vlc := NewVLC(mockClient)
+
+		result, err := vlc.ImportM3U(strings.NewReader("relative.mp3\n/absolute.mp3\n"), "")
+		require.NoError(t, err)
+
+		assert.Equal(t, []M3UEntry{{Location: "file:///absolute.mp3", Duration: -1}}, result.Enqueued)
+
+		require.Len(t, result.Failed, 1)
+		assert.ErrorIs(t, result.Failed[0].Err, errInvalidBaseURI)
+	})
+
+	t.Run("invalid base URI", func(t *testing.T) {
+		t.Parallel()
+
+		vlc := NewVLC(&mockClient{})
+
+		result, err := vlc.ImportM3U(strings.NewReader("file.mp3\n"), "://invalid")
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, errInvalidBaseURI)
+	})
+}
@@ -0,0 +1,89 @@
+package vlc
+
+import (
+	"path"
+	"strings"
+)
+
+// MediaType is a media file category, matched by the file extension
+type MediaType int
+
+const (
+	MediaAudio MediaType = iota
+	MediaVideo
+	MediaImage
+)
+
+// mediaExtensions are the (lowercase) file extensions of each media type,
+// a subset of the ones VLC recognizes
+var mediaExtensions = map[MediaType]map[string]struct{}{
+	MediaAudio: newExtensionSet(
+		".aac", ".ac3", ".aif", ".aiff", ".alac", ".amr", ".ape", ".au", ".dts", ".flac", ".it", ".m4a", ".m4b",
+		".mka", ".mod", ".mp1", ".mp2", ".mp3", ".mpc", ".oga", ".ogg", ".opus", ".ra", ".s3m", ".spx", ".tta",
+		".voc", ".wav", ".wma", ".wv", ".xm",
+	),
+	MediaVideo: newExtensionSet(
+		".3g2", ".3gp", ".asf", ".avi", ".divx", ".dv", ".f4v", ".flv", ".m2t", ".m2ts", ".m4v", ".mkv", ".mov",
+		".mp4", ".mpeg", ".mpg", ".mts", ".mxf", ".nsv", ".nuv", ".ogm", ".ogv", ".ps", ".rm", ".rmvb", ".ts",
+		".vob", ".webm", ".wmv", ".wtv",
+	),
+	MediaImage: newExtensionSet(
+		".bmp", ".gif", ".jpeg", ".jpg", ".png", ".tga", ".tif", ".tiff", ".webp",
+	),
+}
+
+// mediaTypeNames are the string representations of the media types
+var mediaTypeNames = map[MediaType]string{
+	MediaAudio: "audio",
+	MediaVideo: "video",
+	MediaImage: "image",
+}
+
+// String returns the string representation of the media type
+func (t MediaType) String() string {
+	if name, ok := mediaTypeNames[t]; ok {
+		return name
+	}
+
+	return "unknown"
+}
+
+// Matches checks if the file name (or path, or URI) has an extension of the media type
+func (t MediaType) Matches(name string) bool {
+	_, ok := mediaExtensions[t][extension(name)]
+
+	return ok
+}
+
+// MediaTypeOf returns the media type of the file name (or path, or URI), if it is a known media file
+func MediaTypeOf(name string) (MediaType, bool) {
+	for _, mediaType := range []MediaType{MediaAudio, MediaVideo, MediaImage} {
+		if mediaType.Matches(name) {
+			return mediaType, true
+		}
+	}
+
+	return 0, false
+}
+
+// extension returns the lowercase extension of the file name, with the leading dot
+func extension(name string) string {
+	return strings.ToLower(path.Ext(name))
+}
+
+// newExtensionSet creates a lookup set of the given extensions,
+// which are normalized to lowercase with the leading dot
+func newExtensionSet(extensions ...string) map[string]struct{} {
+	set := make(map[string]struct{}, len(extensions))
+
+	for _, ext := range extensions {
+		ext = strings.ToLower(ext)
+		if !strings.HasPrefix(ext, ".") {
+			ext = "." + ext
+		}
+
+		set[ext] = struct{}{}
+	}
+
+	return set
+}
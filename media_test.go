@@ -0,0 +1,37 @@
+package vlc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMediaTypeOf(t *testing.T) {
+	t.Parallel()
+
+	testTable := []struct {
+		name         string
+		file         string
+		expectedType MediaType
+		expectedOk   bool
+	}{
+		{"audio file", "song.MP3", MediaAudio, true},
+		{"video path", "/media/movies/movie.mkv", MediaVideo, true},
+		{"image URI", "file:///media/cover%20art.jpg", MediaImage, true},
+		{"unknown extension", "notes.txt", 0, false},
+		{"no extension", "README", 0, false},
+	}
+
+	for _, testCase := range testTable {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			mediaType, ok := MediaTypeOf(testCase.file)
+
+			assert.Equal(t, testCase.expectedType, mediaType)
+			assert.Equal(t, testCase.expectedOk, ok)
+		})
+	}
+}
@@ -1,9 +1,18 @@
 package vlc
 
-type getDelegate func(string) ([]byte, error)
+import (
+	"context"
+	"io"
+)
+
+type (
+	getDelegate        func(string) ([]byte, error)
+	getContextDelegate func(context.Context, string) ([]byte, error)
+)
 
 type mockClient struct {
-	getFn getDelegate
+	getFn        getDelegate
+	getContextFn getContextDelegate
 }
 
 func (m *mockClient) Get(endpoint string) ([]byte, error) {
@@ -13,3 +22,27 @@ func (m *mockClient) Get(endpoint string) ([]byte, error) {
 
 	return nil, nil
 }
+
+func (m *mockClient) GetContext(ctx context.Context, endpoint string) ([]byte, error) {
+	if m.getContextFn != nil {
+		return m.getContextFn(ctx, endpoint)
+	}
+
+	return m.Get(endpoint)
+}
+
+type getStreamContextDelegate func(context.Context, string) (io.ReadCloser, string, error)
+
+type mockStreamClient struct {
+	mockClient
+
+	getStreamContextFn getStreamContextDelegate
+}
+
+func (m *mockStreamClient) GetStreamContext(ctx context.Context, endpoint string) (io.ReadCloser, string, error) {
+	if m.getStreamContextFn != nil {
+		return m.getStreamContextFn(ctx, endpoint)
+	}
+
+	return nil, "", nil
+}
@@ -0,0 +1,297 @@
+/*
+Package mrl builds and parses VLC media resource locators (MRLs), such as:
+
+	file:///media/Some%20Movie.mkv
+	file:///C:/Movies/Some%20Movie.mkv
+	dvd:///dev/sr0#2:3
+	udp://@239.0.0.1:1234
+	screen://
+
+MRLs can hold a #title:chapter[-title:chapter] section fragment, and per-item options (":name=value"),
+which are passed to VLC separately from the MRL itself (for example, as raw input options).
+Network MRLs (http, https, rtsp) keep any other fragment as the URL fragment.
+The syntax is outlined here:
+https://wiki.videolan.org/Media_resource_locator/
+*/
+package mrl
+
+import (
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Scheme is the MRL access scheme
+type Scheme string
+
+const (
+	SchemeFile   Scheme = "file"
+	SchemeDVD    Scheme = "dvd"
+	SchemeBluray Scheme = "bluray"
+	SchemeCDDA   Scheme = "cdda"
+	SchemeV4L2   Scheme = "v4l2"
+	SchemeHTTP   Scheme = "http"
+	SchemeHTTPS  Scheme = "https"
+	SchemeRTSP   Scheme = "rtsp"
+	SchemeUDP    Scheme = "udp"
+	SchemeScreen Scheme = "screen"
+)
+
+const (
+	// schemeSeparator separates the scheme from the rest of the MRL
+	schemeSeparator = "://"
+
+	// sectionPrefix prefixes the title and chapter section fragment
+	sectionPrefix = "#"
+
+	// optionPrefix prefixes the per-item options
+	optionPrefix = ":"
+)
+
+var (
+	// windowsDriveRegex matches Windows paths starting with a drive letter, such as C:\
+	windowsDriveRegex = regexp.MustCompile(`^[A-Za-z]:([\\/]|$)`)
+
+	// uriDriveRegex matches decoded URI paths starting with a drive letter, such as /C:/
+	uriDriveRegex = regexp.MustCompile(`^/[A-Za-z]:(/|$)`)
+)
+
+// Position is a title, and an optional chapter, in the MRL section fragment
+type Position struct {
+	Title   int
+	Chapter int // 0 if not set, as chapters start from 1
+}
+
+// MRL is a VLC media resource locator
+type MRL struct {
+	User     *url.Userinfo // network credentials (http, https, rtsp and other URL schemes)
+	Start    *Position     // the section start (#title:chapter), if any
+	End      *Position     // the section end (#title:chapter-title:chapter), if any
+	Scheme   Scheme
+	Host     string   // host[:port] of network schemes, the UNC host of Windows files, or the UDP group[:port]
+	Path     string   // the decoded path, or device. Windows paths use backslashes (C:\Movies)
+	RawQuery string   // the encoded query of network schemes, without the '?'
+	Fragment string   // the decoded fragment of network schemes, without the '#', if it isn't a section
+	Source   string   // the UDP source address, for source-specific multicast (source@group)
+	Options  []string // per-item options, such as ":start-time=10"
+}
+
+// File creates a file MRL for the absolute path on the host running VLC.
+// Both POSIX (/media/movie.mkv) and Windows (C:\Movies\movie.mkv, \\server\share\movie.mkv) paths are supported
+func File(path string) *MRL {
+	return devicePath(SchemeFile, path)
+}
+
+// DVD creates a DVD MRL for the device (or folder, or ISO), which can be empty for the default device
+func DVD(device string) *MRL {
+	return devicePath(SchemeDVD, device)
+}
+
+// Bluray creates a Blu-ray MRL for the device (or folder, or ISO), which can be empty for the default device
+func Bluray(device string) *MRL {
+	return devicePath(SchemeBluray, device)
+}
+
+// CDDA creates an audio CD MRL for the device, which can be empty for the default device.
+// Tracks are selected as titles (see WithTitle)
+func CDDA(device string) *MRL {
+	return devicePath(SchemeCDDA, device)
+}
+
+// V4L2 creates a Video4Linux2 capture MRL for the device (/dev/video0),
+// which can be empty for the default device
+func V4L2(device string) *MRL {
+	return devicePath(SchemeV4L2, device)
+}
+
+// Screen creates a screen capture MRL
+func Screen() *MRL {
+	return &MRL{Scheme: SchemeScreen}
+}
+
+// HTTP creates an HTTP MRL for the host[:port], and the path
+func HTTP(host, path string) *MRL {
+	return &MRL{Scheme: SchemeHTTP, Host: host, Path: path}
+}
+
+// HTTPS creates an HTTPS MRL for the host[:port], and the path
+func HTTPS(host, path string) *MRL {
+	return &MRL{Scheme: SchemeHTTPS, Host: host, Path: path}
+}
+
+// RTSP creates an RTSP MRL for the host[:port], and the path
+func RTSP(host, path string) *MRL {
+	return &MRL{Scheme: SchemeRTSP, Host: host, Path: path}
+}
+
+// UDP creates a UDP MRL that listens on the (multicast) group and port.
+// The group can be empty, to listen on all interfaces (udp://@:1234)
+func UDP(group string, port int) *MRL {
+	return &MRL{Scheme: SchemeUDP, Host: group + ":" + strconv.Itoa(port)}
+}
+
+// devicePath creates an MRL for a local path, or device
+func devicePath(scheme Scheme, path string) *MRL {
+	m := &MRL{Scheme: scheme, Path: path}
+
+	// UNC paths hold the host
+	if strings.HasPrefix(path, `\\`) {
+		host, rest, _ := strings.Cut(path[2:], `\`)
+
+		m.Host = host
+		m.Path = `\` + rest
+	}
+
+	return m
+}
+
+// WithTitle sets the title (and chapter, if greater than 0) to start from
+func (m *MRL) WithTitle(title, chapter int) *MRL {
+	m.Start = &Position{Title: title, Chapter: chapter}
+
+	return m
+}
+
+// WithEnd sets the title (and chapter, if greater than 0) to stop at
+func (m *MRL) WithEnd(title, chapter int) *MRL {
+	m.End = &Position{Title: title, Chapter: chapter}
+
+	return m
+}
+
+// WithOption adds a per-item ":name=value" option
+func (m *MRL) WithOption(name, value string) *MRL {
+	m.Options = append(m.Options, optionPrefix+name+"="+value)
+
+	return m
+}
+
+// WithFlag adds a per-item ":name" option, such as ":no-audio"
+func (m *MRL) WithFlag(name string) *MRL {
+	m.Options = append(m.Options, optionPrefix+name)
+
+	return m
+}
+
+// hasURLSyntax checks if the MRL scheme uses the generic URL syntax,
+// such as http, https and rtsp
+func (m *MRL) hasURLSyntax() bool {
+	switch m.Scheme {
+	case SchemeFile, SchemeDVD, SchemeBluray, SchemeCDDA, SchemeV4L2, SchemeUDP, SchemeScreen:
+		return false
+	default:
+		return true
+	}
+}
+
+// IsWindowsPath checks if the file path is a Windows path
+func (m *MRL) IsWindowsPath() bool {
+	return windowsDriveRegex.MatchString(m.Path) || (m.Host != "" && strings.HasPrefix(m.Path, `\`))
+}
+
+// String returns the MRL, without the per-item options.
+// The options are passed separately, for example as raw input options
+func (m *MRL) String() string {
+	var builder strings.Builder
+
+	builder.WriteString(string(m.Scheme))
+	builder.WriteString(schemeSeparator)
+
+	switch m.Scheme {
+	case SchemeFile, SchemeDVD, SchemeBluray, SchemeCDDA, SchemeV4L2:
+		builder.WriteString(m.Host)
+		builder.WriteString(m.encodedPath())
+	case SchemeUDP:
+		builder.WriteString(m.Source)
+		builder.WriteString("@")
+		builder.WriteString(m.Host)
+	case SchemeScreen:
+	default:
+		u := &url.URL{
+			User:     m.User,
+			Host:     m.Host,
+			Path:     m.Path,
+			RawQuery: m.RawQuery,
+		}
+
+		// The section takes the fragment place
+		if m.Start == nil {
+			u.Fragment = m.Fragment
+		}
+
+		// Strip the leading "//", as the scheme separator is already written
+		builder.WriteString(strings.TrimPrefix(u.String(), "//"))
+	}
+
+	if m.Start != nil {
+		builder.WriteString(sectionPrefix)
+		builder.WriteString(m.Start.String())
+
+		if m.End != nil {
+			builder.WriteString("-")
+			builder.WriteString(m.End.String())
+		}
+	}
+
+	return builder.String()
+}
+
+// encodedPath returns the percent-encoded path of a local path MRL,
+// the same way VLC does (vlc_path2uri)
+func (m *MRL) encodedPath() string {
+	if m.Path == "" {
+		return ""
+	}
+
+	path := m.Path
+
+	if m.IsWindowsPath() {
+		path = strings.ReplaceAll(path, `\`, "/")
+
+		if windowsDriveRegex.MatchString(path) {
+			// The drive colon isn't encoded
+			return "/" + path[:2] + escapePath(path[2:])
+		}
+	}
+
+	return escapePath(path)
+}
+
+// String returns the title[:chapter] representation of the position
+func (p *Position) String() string {
+	position := strconv.Itoa(p.Title)
+
+	if p.Chapter > 0 {
+		position += ":" + strconv.Itoa(p.Chapter)
+	}
+
+	return position
+}
+
+// escapePath percent-encodes everything in the path,
+// apart from the RFC 3986 unreserved characters and slashes
+func escapePath(path string) string {
+	const upperHex = "0123456789ABCDEF"
+
+	var builder strings.Builder
+
+	builder.Grow(len(path))
+
+	for i := 0; i < len(path); i++ {
+		c := path[i]
+
+		switch {
+		case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9':
+			builder.WriteByte(c)
+		case c == '-', c == '.', c == '_', c == '~', c == '/':
+			builder.WriteByte(c)
+		default:
+			builder.WriteByte('%')
+			builder.WriteByte(upperHex[c>>4])
+			builder.WriteByte(upperHex[c&0x0F])
+		}
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,181 @@
+package mrl
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+var (
+	errEmptyMRL       = errors.New("empty MRL")
+	errMissingScheme  = errors.New("missing scheme")
+	errInvalidSection = errors.New("invalid title / chapter section")
+)
+
+// Parse parses the MRL, with an optional #title:chapter section fragment,
+// followed by optional whitespace separated per-item options (file:///media/movie.mkv :start-time=10).
+// Absolute paths without a scheme (/media/movie.mkv, C:\Movies\movie.mkv) are parsed as file MRLs
+func Parse(raw string) (*MRL, error) {
+	raw, options := splitOptions(strings.TrimSpace(raw))
+	if raw == "" {
+		return nil, errEmptyMRL
+	}
+
+	rawScheme, rest, found := strings.Cut(raw, schemeSeparator)
+	if !found {
+		if strings.HasPrefix(raw, "/") || strings.HasPrefix(raw, `\\`) || windowsDriveRegex.MatchString(raw) {
+			m := File(raw)
+			m.Options = options
+
+			return m, nil
+		}
+
+		return nil, fmt.Errorf("%w, %s", errMissingScheme, raw)
+	}
+
+	m := &MRL{
+		Scheme:  Scheme(strings.ToLower(rawScheme)),
+		Options: options,
+	}
+
+	// '#' is percent-encoded in the MRL itself, so it starts the section fragment.
+	// URL schemes can also have a regular fragment (#anchor), which is kept
+	if index := strings.LastIndex(rest, sectionPrefix); index >= 0 {
+		start, end, err := parseSections(rest[index+1:])
+
+		switch {
+		case err == nil:
+			rest = rest[:index]
+			m.Start, m.End = start, end
+		case !m.hasURLSyntax():
+			return nil, err
+		}
+	}
+
+	switch m.Scheme {
+	case SchemeFile, SchemeDVD, SchemeBluray, SchemeCDDA, SchemeV4L2:
+		if err := m.parseLocalPath(rest); err != nil {
+			return nil, err
+		}
+	case SchemeUDP:
+		source, host, hasGroup := strings.Cut(rest, "@")
+		if !hasGroup {
+			host, source = source, ""
+		}
+
+		m.Source, m.Host = source, host
+	case SchemeScreen:
+	default:
+		u, err := url.Parse("//" + rest)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse MRL, %w", err)
+		}
+
+		m.User, m.Host, m.Path, m.RawQuery, m.Fragment = u.User, u.Host, u.Path, u.RawQuery, u.Fragment
+	}
+
+	return m, nil
+}
+
+// splitOptions splits the trailing per-item options from the MRL.
+// Options start with a colon, after whitespace, and can't hold " :" in their values
+func splitOptions(raw string) (string, []string) {
+	index := strings.Index(raw, " "+optionPrefix)
+	if index == -1 {
+		return raw, nil
+	}
+
+	var options []string
+
+	for _, option := range strings.Split(raw[index+1:], " "+optionPrefix) {
+		option = strings.TrimSpace(strings.TrimPrefix(option, optionPrefix))
+		if option != "" {
+			options = append(options, optionPrefix+option)
+		}
+	}
+
+	return strings.TrimSpace(raw[:index]), options
+}
+
+// parseLocalPath parses the host and the percent-encoded path of a local path MRL,
+// converting Windows paths to their native form
+func (m *MRL) parseLocalPath(rest string) error {
+	host, path := "", rest
+
+	// file://host/path (UNC), unless it's a dvd://D: style drive
+	if rest != "" && !strings.HasPrefix(rest, "/") && !windowsDriveRegex.MatchString(rest) {
+		host, path = rest, ""
+
+		if index := strings.Index(rest, "/"); index >= 0 {
+			host, path = rest[:index], rest[index:]
+		}
+	}
+
+	decoded, err := url.PathUnescape(path)
+	if err != nil {
+		return fmt.Errorf("unable to decode MRL path, %w", err)
+	}
+
+	m.Host = host
+
+	switch {
+	case uriDriveRegex.MatchString(decoded):
+		// file:///C:/Movies
+		decoded = strings.ReplaceAll(decoded[1:], "/", `\`)
+	case windowsDriveRegex.MatchString(decoded):
+		decoded = strings.ReplaceAll(decoded, "/", `\`)
+	case host != "":
+		// file://server/share (UNC)
+		decoded = strings.ReplaceAll(decoded, "/", `\`)
+	}
+
+	m.Path = decoded
+
+	return nil
+}
+
+// parseSections parses the title[:chapter][-title[:chapter]] section fragment
+func parseSections(fragment string) (*Position, *Position, error) {
+	rawStart, rawEnd, hasEnd := strings.Cut(fragment, "-")
+
+	start, err := parsePosition(rawStart)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !hasEnd {
+		return start, nil, nil
+	}
+
+	end, err := parsePosition(rawEnd)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return start, end, nil
+}
+
+// parsePosition parses the title[:chapter] position
+func parsePosition(raw string) (*Position, error) {
+	rawTitle, rawChapter, hasChapter := strings.Cut(raw, ":")
+
+	title, err := strconv.Atoi(rawTitle)
+	if err != nil || title < 0 {
+		return nil, fmt.Errorf("%w, %s", errInvalidSection, raw)
+	}
+
+	position := &Position{Title: title}
+
+	if hasChapter {
+		chapter, chapterErr := strconv.Atoi(rawChapter)
+		if chapterErr != nil || chapter < 1 {
+			return nil, fmt.Errorf("%w, %s", errInvalidSection, raw)
+		}
+
+		position.Chapter = chapter
+	}
+
+	return position, nil
+}
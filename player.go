@@ -0,0 +1,159 @@
+package vlc
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+)
+
+var errInvalidSeekPosition = errors.New("invalid seek position")
+
+var _ Player = (*VLC)(nil)
+
+// Player is the set of playback operations shared by the
+// VLC control interfaces (HTTP, RC)
+type Player interface {
+	// Play starts playback of the current item
+	Play(ctx context.Context) error
+
+	// Pause toggles the playback pause
+	Pause(ctx context.Context) error
+
+	// Stop stops the playback
+	Stop(ctx context.Context) error
+
+	// Next plays the next item in the playlist
+	Next(ctx context.Context) error
+
+	// Previous plays the previous item in the playlist
+	Previous(ctx context.Context) error
+
+	// Add adds the given MRL to the playlist, and starts playing it
+	Add(ctx context.Context, mrl string) error
+
+	// Enqueue adds the given MRL to the playlist
+	Enqueue(ctx context.Context, mrl string) error
+
+	// Clear empties the playlist
+	Clear(ctx context.Context) error
+
+	// Seek seeks the current item to the given (absolute) position, in seconds precision
+	Seek(ctx context.Context, position time.Duration) error
+
+	// Volume returns the current volume [0, 512], where 256 is 100%
+	Volume(ctx context.Context) (int, error)
+
+	// SetVolumeLevel sets the volume [0, 512], where 256 is 100%
+	SetVolumeLevel(ctx context.Context, level int) error
+
+	// State returns the current playback state
+	State(ctx context.Context) (*PlayerState, error)
+}
+
+// PlayerState is the playback state, as reported by any of the control interfaces
+type PlayerState struct {
+	State  string        // [playing, paused, stopped]
+	Time   time.Duration // the current item position
+	Length time.Duration // the current item length
+	Volume int           // [0, 512], where 256 is 100%
+}
+
+// Play starts playback of the last active item
+func (v *VLC) Play(ctx context.Context) error {
+	_, err := v.PlayLastActivePlaylistItemContext(ctx)
+
+	return err
+}
+
+// Pause toggles the playback pause
+func (v *VLC) Pause(ctx context.Context) error {
+	_, err := v.PauseWithLastActivePlaylistItemContext(ctx)
+
+	return err
+}
+
+// Stop stops the playback
+func (v *VLC) Stop(ctx context.Context) error {
+	_, err := v.StopPlaylistContext(ctx)
+
+	return err
+}
+
+// Next plays the next item in the playlist
+func (v *VLC) Next(ctx context.Context) error {
+	_, err := v.PlayNextInPlaylistContext(ctx)
+
+	return err
+}
+
+// Previous plays the previous item in the playlist
+func (v *VLC) Previous(ctx context.Context) error {
+	_, err := v.PlayPreviousInPlaylistContext(ctx)
+
+	return err
+}
+
+// Add adds the given MRL to the playlist, and starts playing it
+func (v *VLC) Add(ctx context.Context, mrl string) error {
+	_, err := v.PlaySourceContext(ctx, mrl)
+
+	return err
+}
+
+// Enqueue adds the given MRL to the playlist
+func (v *VLC) Enqueue(ctx context.Context, mrl string) error {
+	_, err := v.AddToPlaylistContext(ctx, mrl)
+
+	return err
+}
+
+// Clear empties the playlist
+func (v *VLC) Clear(ctx context.Context) error {
+	_, err := v.EmptyPlaylistContext(ctx)
+
+	return err
+}
+
+// Seek seeks the current item to the given (absolute) position, in seconds precision
+func (v *VLC) Seek(ctx context.Context, position time.Duration) error {
+	if position < 0 {
+		return errInvalidSeekPosition
+	}
+
+	_, err := v.SeekToValueContext(ctx, strconv.FormatInt(int64(position/time.Second), 10))
+
+	return err
+}
+
+// Volume returns the current volume [0, 512], where 256 is 100%
+func (v *VLC) Volume(ctx context.Context) (int, error) {
+	status, err := v.GetStatusContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(status.Volume), nil
+}
+
+// SetVolumeLevel sets the volume [0, 512], where 256 is 100%
+func (v *VLC) SetVolumeLevel(ctx context.Context, level int) error {
+	_, err := v.SetVolumeContext(ctx, strconv.Itoa(level))
+
+	return err
+}
+
+// State returns the current playback state
+func (v *VLC) State(ctx context.Context) (*PlayerState, error) {
+	status, err := v.GetStatusContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PlayerState{
+		State:  status.State,
+		Time:   time.Duration(status.Time) * time.Second,
+		Length: time.Duration(status.Length) * time.Second,
+		Volume: int(status.Volume),
+	}, nil
+}
@@ -0,0 +1,162 @@
+package vlc
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVLC_Player(t *testing.T) {
+	t.Parallel()
+
+	testTable := []struct {
+		name           string
+		playerFn       func(ctx context.Context, p Player) error
+		expectedParams paramMap
+	}{
+		{
+			"play",
+			func(ctx context.Context, p Player) error {
+				return p.Play(ctx)
+			},
+			paramMap{commandKey: playCommand},
+		},
+		{
+			"pause",
+			func(ctx context.Context, p Player) error {
+				return p.Pause(ctx)
+			},
+			paramMap{commandKey: pauseCommand},
+		},
+		{
+			"stop",
+			func(ctx context.Context, p Player) error {
+				return p.Stop(ctx)
+			},
+			paramMap{commandKey: stopCommand},
+		},
+		{
+			"next",
+			func(ctx context.Context, p Player) error {
+				return p.Next(ctx)
+			},
+			paramMap{commandKey: nextCommand},
+		},
+		{
+			"previous",
+			func(ctx context.Context, p Player) error {
+				return p.Previous(ctx)
+			},
+			paramMap{commandKey: previousCommand},
+		},
+		{
+			"add",
+			func(ctx context.Context, p Player) error {
+				return p.Add(ctx, "file:///a.mp4")
+			},
+			paramMap{commandKey: inPlayCommand, inputKey: "file:///a.mp4"},
+		},
+		{
+			"enqueue",
+			func(ctx context.Context, p Player) error {
+				return p.Enqueue(ctx, "file:///a.mp4")
+			},
+			paramMap{commandKey: inEnqueueCommand, inputKey: "file:///a.mp4"},
+		},
+		{
+			"clear",
+			func(ctx context.Context, p Player) error {
+				return p.Clear(ctx)
+			},
+			paramMap{commandKey: emptyCommand},
+		},
+		{
+			"seek",
+			func(ctx context.Context, p Player) error {
+				return p.Seek(ctx, 90*time.Second)
+			},
+			paramMap{commandKey: seekCommand, valKey: "90"},
+		},
+		{
+			"set volume level",
+			func(ctx context.Context, p Player) error {
+				return p.SetVolumeLevel(ctx, 300)
+			},
+			paramMap{commandKey: volumeCommand, valKey: "300"},
+		},
+	}
+
+	for _, testCase := range testTable {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockClient := &mockClient{
+				getFn: func(endpoint string) ([]byte, error) {
+					require.Equal(
+						t,
+						buildQueryEndpoint(baseStatus, testCase.expectedParams),
+						endpoint,
+					)
+
+					return json.Marshal(&Status{})
+				},
+			}
+
+			vlc := NewVLC(mockClient)
+
+			assert.NoError(t, testCase.playerFn(context.Background(), vlc))
+		})
+	}
+}
+
+func TestVLC_Player_InvalidSeek(t *testing.T) {
+	t.Parallel()
+
+	vlc := NewVLC(&mockClient{})
+
+	assert.ErrorIs(t, vlc.Seek(context.Background(), -time.Second), errInvalidSeekPosition)
+}
+
+func TestVLC_Player_State(t *testing.T) {
+	t.Parallel()
+
+	mockClient := &mockClient{
+		getFn: func(endpoint string) ([]byte, error) {
+			require.Equal(t, baseStatus, endpoint)
+
+			return json.Marshal(&Status{
+				State:  "paused",
+				Time:   42,
+				Length: 596,
+				Volume: 256,
+			})
+		},
+	}
+
+	vlc := NewVLC(mockClient)
+
+	state, err := vlc.State(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(
+		t,
+		&PlayerState{
+			State:  "paused",
+			Time:   42 * time.Second,
+			Length: 596 * time.Second,
+			Volume: 256,
+		},
+		state,
+	)
+
+	volume, err := vlc.Volume(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, 256, volume)
+}
@@ -1,16 +1,17 @@
 package vlc
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/zivkovicmilos/go-vlc/client"
 )
 
 // executeStatusRequest executes a GET request and parses the response JSON
-func (v *VLC) executePlaylistRequest(params paramMap) (*Playlist, error) {
+func (v *VLC) executePlaylistRequest(ctx context.Context, params queryParams) (*Playlist, error) {
 	endpoint := buildQueryEndpoint(basePlaylist, params)
 
-	playlistRaw, err := v.client.Get(endpoint)
+	playlistRaw, err := client.GetWithContext(ctx, v.client, endpoint)
 	if err != nil {
 		return nil, fmt.Errorf("unable to execute request, %s, %w", endpoint, err)
 	}
@@ -20,5 +21,10 @@ func (v *VLC) executePlaylistRequest(params paramMap) (*Playlist, error) {
 
 // GetPlaylist fetches the current playlist
 func (v *VLC) GetPlaylist() (*Playlist, error) {
-	return v.executePlaylistRequest(nil)
+	return v.GetPlaylistContext(context.Background())
+}
+
+// GetPlaylistContext is GetPlaylist with a context that controls the request lifetime
+func (v *VLC) GetPlaylistContext(ctx context.Context) (*Playlist, error) {
+	return v.executePlaylistRequest(ctx, nil)
 }
@@ -0,0 +1,156 @@
+package vlc
+
+import (
+	"context"
+	"net/url"
+	"strings"
+)
+
+// AddToPlaylistItems adds a source (URI) to the playlist, like AddToPlaylist,
+// and returns the created playlist items (usually a single one).
+//
+// The items are found by comparing the playlist before and after the enqueue, so the source
+// can already be in the playlist. Only the new items matching the source URI are returned,
+// so items enqueued by other clients at the same time are left out. If VLC changed the source URI
+// beyond recognition, no items are returned
+func (v *VLC) AddToPlaylistItems(source string) ([]Playlist, error) {
+	return v.AddToPlaylistItemsContext(context.Background(), source)
+}
+
+// AddToPlaylistItemsContext is AddToPlaylistItems with a context that controls the request lifetime
+func (v *VLC) AddToPlaylistItemsContext(ctx context.Context, source string) ([]Playlist, error) {
+	return v.enqueueItems(ctx, source, func() error {
+		_, err := v.AddToPlaylistContext(ctx, source)
+
+		return err
+	})
+}
+
+// PlaySourceItems plays a source (URI), like PlaySource, and returns the created playlist items.
+// The items are found the same way as in AddToPlaylistItems
+func (v *VLC) PlaySourceItems(source string, option ...string) ([]Playlist, error) {
+	return v.PlaySourceItemsContext(context.Background(), source, option...)
+}
+
+// PlaySourceItemsContext is PlaySourceItems with a context that controls the request lifetime
+func (v *VLC) PlaySourceItemsContext(ctx context.Context, source string, option ...string) ([]Playlist, error) {
+	return v.enqueueItems(ctx, source, func() error {
+		_, err := v.PlaySourceContext(ctx, source, option...)
+
+		return err
+	})
+}
+
+// enqueueItems runs the enqueue, and returns the new playlist items matching the source.
+// The result is empty if none of the new items match the source
+func (v *VLC) enqueueItems(ctx context.Context, source string, enqueue func() error) ([]Playlist, error) {
+	before, err := v.GetPlaylistContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = enqueue(); err != nil {
+		return nil, err
+	}
+
+	after, err := v.GetPlaylistContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	matched := matchEnqueuedItems(newQueueItems(before, after), []string{source})
+
+	result := make([]Playlist, 0, len(matched))
+
+	for _, item := range matched {
+		result = append(result, *item)
+	}
+
+	return result, nil
+}
+
+// playQueue returns the "Playlist" node of the playlist, or the playlist itself
+func playQueue(playlist *Playlist) *Playlist {
+	if node := playlist.PlaylistNode(); node != nil {
+		return node
+	}
+
+	return playlist
+}
+
+// newQueueItems returns the play queue items that weren't in it before, in depth-first order.
+// The children of new nodes aren't returned, as they are part of the new node
+func newQueueItems(before, after *Playlist) []*Playlist {
+	var (
+		existing = make(map[string]struct{})
+		items    = make([]*Playlist, 0)
+
+		queue = playQueue(after)
+	)
+
+	_ = playQueue(before).Walk(func(item *Playlist, _ []*Playlist) error {
+		existing[item.ID] = struct{}{}
+
+		return nil
+	})
+
+	_ = queue.Walk(func(item *Playlist, _ []*Playlist) error {
+		if item == queue {
+			return nil
+		}
+
+		if _, ok := existing[item.ID]; ok {
+			return nil
+		}
+
+		items = append(items, item)
+
+		return ErrSkipChildren
+	})
+
+	return items
+}
+
+// matchEnqueuedItems matches the enqueued URIs, in order, with the new items.
+// URIs without a matching item (for example, if VLC changed the URI) are skipped
+func matchEnqueuedItems(items []*Playlist, uris []string) []*Playlist {
+	var (
+		matched = make([]*Playlist, 0, len(uris))
+		used    = make([]bool, len(items))
+	)
+
+	for _, uri := range uris {
+		for i, item := range items {
+			if !used[i] && sameURI(item.URI, uri) {
+				used[i] = true
+				matched = append(matched, item)
+
+				break
+			}
+		}
+	}
+
+	return matched
+}
+
+// sameURI checks if the playlist item URI matches the enqueued source, which VLC
+// can change by percent-encoding it, or by converting an absolute path to a file:// URI
+func sameURI(uri, source string) bool {
+	if uri == source {
+		return true
+	}
+
+	normalize := func(value string) string {
+		if strings.HasPrefix(value, "/") {
+			value = "file://" + value
+		}
+
+		if unescaped, err := url.PathUnescape(value); err == nil {
+			return unescaped
+		}
+
+		return value
+	}
+
+	return normalize(uri) == normalize(source)
+}
@@ -0,0 +1,220 @@
+package vlc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVLC_AddToPlaylistItems(t *testing.T) {
+	t.Parallel()
+
+	t.Run("new item returned", func(t *testing.T) {
+		t.Parallel()
+
+		queue := newFakeQueue(t, "file:///media/a.mp3")
+
+		vlc := NewVLC(queue.client(t))
+
+		items, err := vlc.AddToPlaylistItems("file:///media/b.mp3")
+		require.NoError(t, err)
+
+		require.Len(t, items, 1)
+		assert.Equal(t, "4", items[0].ID)
+		assert.Equal(t, "file:///media/b.mp3", items[0].URI)
+	})
+
+	t.Run("source already queued", func(t *testing.T) {
+		t.Parallel()
+
+		queue := newFakeQueue(t, "file:///media/a.mp3", "file:///media/b.mp3")
+
+		vlc := NewVLC(queue.client(t))
+
+		items, err := vlc.AddToPlaylistItems("file:///media/a.mp3")
+		require.NoError(t, err)
+
+		require.Len(t, items, 1)
+		assert.Equal(t, "5", items[0].ID)
+	})
+
+	t.Run("source URI changed by VLC", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			queue  = newFakeQueue(t)
+			client = queue.client(t)
+		)
+
+		// VLC converts paths to file:// URIs
+		vlc := NewVLC(&mockClient{
+			getContextFn: func(ctx context.Context, endpoint string) ([]byte, error) {
+				return client.GetContext(ctx, strings.Replace(endpoint, "input=%2F", "input=file%3A%2F%2F%2F", 1))
+			},
+		})
+
+		items, err := vlc.AddToPlaylistItems("/media/b.mp3")
+		require.NoError(t, err)
+
+		require.Len(t, items, 1)
+		assert.Equal(t, "file:///media/b.mp3", items[0].URI)
+	})
+
+	t.Run("unrelated item enqueued at the same time", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			queue  = newFakeQueue(t, "file:///media/a.mp3")
+			client = queue.client(t)
+		)
+
+		// Another client enqueues an item right before this one
+		vlc := NewVLC(&mockClient{
+			getContextFn: func(ctx context.Context, endpoint string) ([]byte, error) {
+				if endpoint != basePlaylist {
+					queue.mu.Lock()
+					queue.add("file:///media/other.mp3")
+					queue.mu.Unlock()
+				}
+
+				return client.GetContext(ctx, endpoint)
+			},
+		})
+
+		items, err := vlc.AddToPlaylistItems("file:///media/b.mp3")
+		require.NoError(t, err)
+
+		require.Len(t, items, 1)
+		assert.Equal(t, "5", items[0].ID)
+		assert.Equal(t, "file:///media/b.mp3", items[0].URI)
+	})
+
+	t.Run("no new item matches the source", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			queue  = newFakeQueue(t, "file:///media/a.mp3")
+			client = queue.client(t)
+		)
+
+		// Only an unrelated item is enqueued
+		vlc := NewVLC(&mockClient{
+			getContextFn: func(ctx context.Context, endpoint string) ([]byte, error) {
+				return client.GetContext(ctx, strings.Replace(endpoint, "b.mp3", "other.mp3", 1))
+			},
+		})
+
+		items, err := vlc.AddToPlaylistItems("file:///media/b.mp3")
+		require.NoError(t, err)
+
+		assert.Empty(t, items)
+		assert.NotNil(t, items)
+	})
+
+	t.Run("enqueue error", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			enqueueErr = errors.New("enqueue error")
+			mockClient = &mockClient{
+				getFn: func(endpoint string) ([]byte, error) {
+					if endpoint == basePlaylist {
+						return json.Marshal(newTestPlaylist(""))
+					}
+
+					return nil, enqueueErr
+				},
+			}
+		)
+
+		vlc := NewVLC(mockClient)
+
+		items, err := vlc.AddToPlaylistItems("file:///media/b.mp3")
+
+		assert.Nil(t, items)
+		assert.ErrorIs(t, err, enqueueErr)
+	})
+}
+
+func TestVLC_PlaySourceItems(t *testing.T) {
+	t.Parallel()
+
+	queue := newFakeQueue(t, "file:///media/a.mp3")
+
+	vlc := NewVLC(queue.client(t))
+
+	items, err := vlc.PlaySourceItems("file:///media/a.mp3", playNoVideo)
+	require.NoError(t, err)
+
+	require.Len(t, items, 1)
+	assert.Equal(t, "4", items[0].ID)
+
+	require.Len(t, queue.enqueued, 1)
+	assert.Equal(t, inPlayCommand, queue.enqueued[0].Get(commandKey))
+	assert.Equal(t, playNoVideo, queue.enqueued[0].Get(optionKey))
+}
+
+func TestNewQueueItems(t *testing.T) {
+	t.Parallel()
+
+	before := newTestTree()
+	after := newTestTree()
+
+	// A new node, with its children, and a new leaf
+	queue := after.PlaylistNode()
+	queue.Children = append(
+		queue.Children,
+		Playlist{
+			ID:   "20",
+			Type: playlistTypeNode,
+			Name: "folder",
+			URI:  "file:///media/folder",
+			Children: []Playlist{
+				{ID: "21", Type: playlistTypeLeaf, URI: "file:///media/folder/a.mp3"},
+			},
+		},
+		Playlist{ID: "22", Type: playlistTypeLeaf, URI: "file:///media/intro.mp4"},
+	)
+
+	items := newQueueItems(before, after)
+
+	require.Len(t, items, 2)
+	assert.Equal(t, "20", items[0].ID)
+	assert.Equal(t, "22", items[1].ID)
+
+	matched := matchEnqueuedItems(items, []string{"file:///media/intro.mp4", "file:///media/missing.mp4"})
+
+	require.Len(t, matched, 1)
+	assert.Equal(t, "22", matched[0].ID)
+}
+
+func TestSameURI(t *testing.T) {
+	t.Parallel()
+
+	testTable := []struct {
+		name     string
+		uri      string
+		source   string
+		expected bool
+	}{
+		{"identical", "file:///media/a.mp3", "file:///media/a.mp3", true},
+		{"percent-encoded", "file:///media/a%20b.mp3", "file:///media/a b.mp3", true},
+		{"absolute path", "file:///media/a%20b.mp3", "/media/a b.mp3", true},
+		{"different", "file:///media/a.mp3", "file:///media/b.mp3", false},
+	}
+
+	for _, testCase := range testTable {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, testCase.expected, sameURI(testCase.uri, testCase.source))
+		})
+	}
+}
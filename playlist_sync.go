@@ -0,0 +1,194 @@
+package vlc
+
+import (
+	"context"
+	"fmt"
+	"slices"
+)
+
+// PlaylistSyncResult is the outcome of a playlist sync
+type PlaylistSyncResult struct {
+	Kept     []Playlist // the items left in place
+	Deleted  []Playlist // the items removed from the playlist
+	Enqueued []string   // the URIs added to the end of the playlist, in order
+	Rotated  bool       // the desired list was rotated to start at the current item (see SyncPlaylist)
+}
+
+// Changed checks if the sync modified the playlist
+func (r *PlaylistSyncResult) Changed() bool {
+	return len(r.Deleted) > 0 || len(r.Enqueued) > 0
+}
+
+// playlistSyncPlan holds the commands needed to sync the play queue
+type playlistSyncPlan struct {
+	kept    []*Playlist
+	deleted []*Playlist
+	enqueue []string
+	rotated bool
+}
+
+// SyncPlaylist makes the play queue match the desired list of URIs, using the fewest
+// pl_delete and in_enqueue commands. The HTTP interface can only append items,
+// so the items that are kept must already be in the desired order.
+//
+// URIs are compared as VLC reports them (for example, percent-encoded file:// URIs).
+//
+// The current item is never deleted if it is still wanted. If the desired order can't be
+// reached without deleting it, the desired list is rotated to start at the current item,
+// which keeps the order of a looping playlist, and the result is marked as rotated.
+//
+// If a command fails, the changes applied so far are returned with the error
+func (v *VLC) SyncPlaylist(desired []string) (*PlaylistSyncResult, error) {
+	return v.SyncPlaylistContext(context.Background(), desired)
+}
+
+// SyncPlaylistContext is SyncPlaylist with a context that controls the request lifetime
+func (v *VLC) SyncPlaylistContext(ctx context.Context, desired []string) (*PlaylistSyncResult, error) {
+	playlist, err := v.GetPlaylistContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	queue := playlist
+	if node := playlist.PlaylistNode(); node != nil {
+		queue = node
+	}
+
+	plan := planPlaylistSync(queue.Leaves(), queue.CurrentItem(), desired)
+
+	result := &PlaylistSyncResult{
+		Kept:     make([]Playlist, 0, len(plan.kept)),
+		Deleted:  make([]Playlist, 0, len(plan.deleted)),
+		Enqueued: make([]string, 0, len(plan.enqueue)),
+		Rotated:  plan.rotated,
+	}
+
+	for _, item := range plan.kept {
+		result.Kept = append(result.Kept, *item)
+	}
+
+	for _, item := range plan.deleted {
+		id, idErr := item.ItemID()
+		if idErr != nil {
+			return result, fmt.Errorf("invalid playlist item ID, %s, %w", item.ID, idErr)
+		}
+
+		if _, err = v.DeleteFromPlaylistContext(ctx, id); err != nil {
+			return result, err
+		}
+
+		result.Deleted = append(result.Deleted, *item)
+	}
+
+	for _, uri := range plan.enqueue {
+		if _, err = v.AddToPlaylistContext(ctx, uri); err != nil {
+			return result, err
+		}
+
+		result.Enqueued = append(result.Enqueued, uri)
+	}
+
+	return result, nil
+}
+
+// planPlaylistSync plans the commands that turn the queue leaves into the desired list
+func planPlaylistSync(queue []*Playlist, current *Playlist, desired []string) *playlistSyncPlan {
+	currentIndex := slices.Index(queue, current)
+
+	// Without a wanted current item, keep the longest desired prefix already in the queue
+	if current == nil || currentIndex < 0 || !slices.Contains(desired, current.URI) {
+		kept, matched := matchSubsequence(queue, desired)
+
+		return newPlaylistSyncPlan(queue, kept, desired[matched:])
+	}
+
+	var (
+		before = queue[:currentIndex]
+		after  = queue[currentIndex+1:]
+
+		best *playlistSyncPlan
+	)
+
+	// The current item can be matched with any desired occurrence of its URI,
+	// as long as the desired items before it are already in the queue before it
+	for position, uri := range desired {
+		if uri != current.URI {
+			continue
+		}
+
+		keptBefore, matchedBefore := matchSubsequence(before, desired[:position])
+		if matchedBefore < position {
+			continue
+		}
+
+		keptAfter, matchedAfter := matchSubsequence(after, desired[position+1:])
+
+		kept := append(append(keptBefore, true), keptAfter...)
+		plan := newPlaylistSyncPlan(queue, kept, desired[position+1+matchedAfter:])
+
+		if best == nil || len(plan.kept) > len(best.kept) {
+			best = plan
+		}
+	}
+
+	if best != nil {
+		return best
+	}
+
+	// The desired order can't be reached while keeping the current item,
+	// so the desired list is rotated to start with it
+	var (
+		position = slices.Index(desired, current.URI)
+		rotated  = append(slices.Clone(desired[position+1:]), desired[:position]...)
+
+		keptAfter, matchedAfter = matchSubsequence(after, rotated)
+	)
+
+	kept := append(append(make([]bool, len(before)), true), keptAfter...)
+
+	plan := newPlaylistSyncPlan(queue, kept, rotated[matchedAfter:])
+	plan.rotated = true
+
+	return plan
+}
+
+// matchSubsequence greedily matches the longest prefix of the URIs,
+// as a subsequence of the items. It returns which items were matched,
+// and the length of the matched prefix
+func matchSubsequence(items []*Playlist, uris []string) ([]bool, int) {
+	var (
+		kept    = make([]bool, len(items))
+		matched = 0
+	)
+
+	for i, item := range items {
+		if matched < len(uris) && item.URI == uris[matched] {
+			kept[i] = true
+			matched++
+		}
+	}
+
+	return kept, matched
+}
+
+// newPlaylistSyncPlan creates a plan that keeps the marked queue items,
+// deletes the rest, and enqueues the given URIs
+func newPlaylistSyncPlan(queue []*Playlist, kept []bool, enqueue []string) *playlistSyncPlan {
+	plan := &playlistSyncPlan{
+		kept:    make([]*Playlist, 0),
+		deleted: make([]*Playlist, 0),
+		enqueue: slices.Clone(enqueue),
+	}
+
+	for i, item := range queue {
+		if kept[i] {
+			plan.kept = append(plan.kept, item)
+
+			continue
+		}
+
+		plan.deleted = append(plan.deleted, item)
+	}
+
+	return plan
+}
@@ -0,0 +1,291 @@
+package vlc
+
+import (
+	"encoding/json"
+	"errors"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newSyncQueue creates queue leaves with the given URIs, and IDs starting from 10.
+// The leaf at the current index (if any) is flagged as current
+func newSyncQueue(current int, uris ...string) []*Playlist {
+	queue := make([]*Playlist, 0, len(uris))
+
+	for i, uri := range uris {
+		leaf := &Playlist{
+			ID:   strconv.Itoa(10 + i),
+			Type: playlistTypeLeaf,
+			URI:  uri,
+		}
+
+		if i == current {
+			leaf.Current = playlistCurrentFlag
+		}
+
+		queue = append(queue, leaf)
+	}
+
+	return queue
+}
+
+func TestPlanPlaylistSync(t *testing.T) {
+	t.Parallel()
+
+	itemIDs := func(items []*Playlist) []string {
+		result := make([]string, 0, len(items))
+
+		for _, item := range items {
+			result = append(result, item.ID)
+		}
+
+		return result
+	}
+
+	testTable := []struct {
+		name            string
+		queue           []string
+		desired         []string
+		expectedKept    []string
+		expectedDeleted []string
+		expectedEnqueue []string
+		current         int
+		expectedRotated bool
+	}{
+		{
+			"already in sync",
+			[]string{"a", "b", "c"},
+			[]string{"a", "b", "c"},
+			[]string{"10", "11", "12"},
+			[]string{},
+			[]string{},
+			-1,
+			false,
+		},
+		{
+			"everything removed",
+			[]string{"a", "b"},
+			[]string{},
+			[]string{},
+			[]string{"10", "11"},
+			[]string{},
+			-1,
+			false,
+		},
+		{
+			"items appended",
+			[]string{"a", "b"},
+			[]string{"a", "b", "c", "d"},
+			[]string{"10", "11"},
+			[]string{},
+			[]string{"c", "d"},
+			-1,
+			false,
+		},
+		{
+			"item removed from the middle",
+			[]string{"a", "x", "b"},
+			[]string{"a", "b"},
+			[]string{"10", "12"},
+			[]string{"11"},
+			[]string{},
+			-1,
+			false,
+		},
+		{
+			"items reordered",
+			[]string{"b", "a"},
+			[]string{"a", "b"},
+			[]string{"11"},
+			[]string{"10"},
+			[]string{"b"},
+			-1,
+			false,
+		},
+		{
+			"current item kept",
+			[]string{"a", "b", "c"},
+			[]string{"b", "c", "d"},
+			[]string{"11", "12"},
+			[]string{"10"},
+			[]string{"d"},
+			1,
+			false,
+		},
+		{
+			"current duplicate kept",
+			[]string{"a", "a"},
+			[]string{"a"},
+			[]string{"11"},
+			[]string{"10"},
+			[]string{},
+			1,
+			false,
+		},
+		{
+			"current item first in the desired list",
+			[]string{"a", "b", "c"},
+			[]string{"c", "b", "a"},
+			[]string{"12"},
+			[]string{"10", "11"},
+			[]string{"b", "a"},
+			2,
+			false,
+		},
+		{
+			"desired list rotated to keep the current item",
+			[]string{"a", "b", "x"},
+			[]string{"c", "b", "x"},
+			[]string{"11", "12"},
+			[]string{"10"},
+			[]string{"c"},
+			1,
+			true,
+		},
+		{
+			"unwanted current item deleted",
+			[]string{"a", "b"},
+			[]string{"b", "c"},
+			[]string{"11"},
+			[]string{"10"},
+			[]string{"c"},
+			0,
+			false,
+		},
+	}
+
+	for _, testCase := range testTable {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				queue   = newSyncQueue(testCase.current, testCase.queue...)
+				current *Playlist
+			)
+
+			if testCase.current >= 0 {
+				current = queue[testCase.current]
+			}
+
+			plan := planPlaylistSync(queue, current, testCase.desired)
+
+			assert.Equal(t, testCase.expectedKept, itemIDs(plan.kept))
+			assert.Equal(t, testCase.expectedDeleted, itemIDs(plan.deleted))
+			assert.Equal(t, testCase.expectedEnqueue, plan.enqueue)
+			assert.Equal(t, testCase.expectedRotated, plan.rotated)
+		})
+	}
+}
+
+func TestVLC_SyncPlaylist(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unable to fetch playlist", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			fetchErr   = errors.New("fetch error")
+			mockClient = &mockClient{
+				getFn: func(string) ([]byte, error) {
+					return nil, fetchErr
+				},
+			}
+		)
+
+		vlc := NewVLC(mockClient)
+
+		result, err := vlc.SyncPlaylist([]string{"file:///media/1.mp4"})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, fetchErr)
+	})
+
+	t.Run("playlist synced", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			commands = make([]url.Values, 0)
+
+			mockClient = &mockClient{
+				getFn: func(endpoint string) ([]byte, error) {
+					if endpoint == basePlaylist {
+						return json.Marshal(newTestPlaylist("4", "3", "4", "5"))
+					}
+
+					parsed, err := url.Parse(endpoint)
+					require.NoError(t, err)
+
+					commands = append(commands, parsed.Query())
+
+					return json.Marshal(&Status{})
+				},
+			}
+		)
+
+		vlc := NewVLC(mockClient)
+
+		result, err := vlc.SyncPlaylist([]string{
+			"file:///media/4.mp4",
+			"file:///media/6.mp4",
+		})
+		require.NoError(t, err)
+
+		assert.True(t, result.Changed())
+		assert.False(t, result.Rotated)
+
+		require.Len(t, result.Kept, 1)
+		assert.Equal(t, "4", result.Kept[0].ID)
+
+		require.Len(t, result.Deleted, 2)
+		assert.Equal(t, "3", result.Deleted[0].ID)
+		assert.Equal(t, "5", result.Deleted[1].ID)
+
+		assert.Equal(t, []string{"file:///media/6.mp4"}, result.Enqueued)
+
+		require.Len(t, commands, 3)
+		assert.Equal(t, deleteCommand, commands[0].Get(commandKey))
+		assert.Equal(t, "3", commands[0].Get(idKey))
+		assert.Equal(t, "5", commands[1].Get(idKey))
+		assert.Equal(t, inEnqueueCommand, commands[2].Get(commandKey))
+		assert.Equal(t, "file:///media/6.mp4", commands[2].Get(inputKey))
+	})
+
+	t.Run("command failure", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			deleteErr  = errors.New("delete error")
+			mockClient = &mockClient{
+				getFn: func(endpoint string) ([]byte, error) {
+					if endpoint == basePlaylist {
+						return json.Marshal(newTestPlaylist("", "3", "4"))
+					}
+
+					parsed, err := url.Parse(endpoint)
+					require.NoError(t, err)
+
+					if parsed.Query().Get(idKey) == "4" {
+						return nil, deleteErr
+					}
+
+					return json.Marshal(&Status{})
+				},
+			}
+		)
+
+		vlc := NewVLC(mockClient)
+
+		result, err := vlc.SyncPlaylist(nil)
+		require.ErrorIs(t, err, deleteErr)
+
+		// The changes applied before the failure are reported
+		require.Len(t, result.Deleted, 1)
+		assert.Equal(t, "3", result.Deleted[0].ID)
+	})
+}
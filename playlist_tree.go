@@ -0,0 +1,181 @@
+package vlc
+
+import (
+	"errors"
+	"strconv"
+)
+
+// ErrSkipChildren is returned from a WalkFunc, to skip the children of the current node.
+// It is not returned as an error by Walk
+var ErrSkipChildren = errors.New("skip children")
+
+const (
+	playlistTypeLeaf    = "leaf"
+	playlistTypeNode    = "node"
+	playlistCurrentFlag = "current"
+
+	playlistNodeName     = "Playlist"
+	mediaLibraryNodeName = "Media Library"
+)
+
+// WalkFunc is called for every playlist item, with the path of its
+// parent nodes, starting from the root. The path must not be retained
+type WalkFunc func(item *Playlist, path []*Playlist) error
+
+// IsLeaf checks if the item is a playable leaf (as opposed to a node)
+func (p *Playlist) IsLeaf() bool {
+	return p.Type == playlistTypeLeaf
+}
+
+// IsNode checks if the item is a node, holding other items
+func (p *Playlist) IsNode() bool {
+	return p.Type == playlistTypeNode
+}
+
+// IsCurrent checks if the item is the current playlist item
+func (p *Playlist) IsCurrent() bool {
+	return p.Current == playlistCurrentFlag
+}
+
+// ItemID returns the numeric item ID, as used by PlayPlaylistItem and DeleteFromPlaylist
+func (p *Playlist) ItemID() (int, error) {
+	return strconv.Atoi(p.ID)
+}
+
+// Walk walks the playlist tree depth-first, starting with the item itself.
+// Returning ErrSkipChildren from the function skips the children of the item,
+// and any other error stops the walk
+func (p *Playlist) Walk(fn WalkFunc) error {
+	path := make([]*Playlist, 0)
+
+	err := walkPlaylist(p, path, fn)
+	if errors.Is(err, ErrSkipChildren) {
+		return nil
+	}
+
+	return err
+}
+
+// walkPlaylist walks the item, and its children recursively
+func walkPlaylist(item *Playlist, path []*Playlist, fn WalkFunc) error {
+	if err := fn(item, path); err != nil {
+		return err
+	}
+
+	path = append(path, item)
+
+	for i := range item.Children {
+		err := walkPlaylist(&item.Children[i], path, fn)
+		if err != nil && !errors.Is(err, ErrSkipChildren) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// find returns the items that match the given predicate, in depth-first order
+func (p *Playlist) find(match func(item *Playlist) bool) []*Playlist {
+	items := make([]*Playlist, 0)
+
+	_ = p.Walk(func(item *Playlist, _ []*Playlist) error {
+		if match(item) {
+			items = append(items, item)
+		}
+
+		return nil
+	})
+
+	return items
+}
+
+// Leaves flattens the playlist tree into its playable leaves, in depth-first order
+func (p *Playlist) Leaves() []*Playlist {
+	return p.find(func(item *Playlist) bool {
+		return item.IsLeaf()
+	})
+}
+
+// FindByID returns the item with the given ID, if any
+func (p *Playlist) FindByID(id string) *Playlist {
+	var found *Playlist
+
+	errFound := errors.New("found")
+
+	_ = p.Walk(func(item *Playlist, _ []*Playlist) error {
+		if item.ID == id {
+			found = item
+
+			return errFound
+		}
+
+		return nil
+	})
+
+	return found
+}
+
+// FindByURI returns the items with the given URI.
+// The same URI can be present in the playlist more than once
+func (p *Playlist) FindByURI(uri string) []*Playlist {
+	return p.find(func(item *Playlist) bool {
+		return item.URI == uri
+	})
+}
+
+// FindByName returns the items with the given name
+func (p *Playlist) FindByName(name string) []*Playlist {
+	return p.find(func(item *Playlist) bool {
+		return item.Name == name
+	})
+}
+
+// CurrentItem returns the current playlist item, if any
+func (p *Playlist) CurrentItem() *Playlist {
+	current := p.find(func(item *Playlist) bool {
+		return item.IsCurrent()
+	})
+
+	if len(current) == 0 {
+		return nil
+	}
+
+	return current[0]
+}
+
+// PlaylistNode returns the "Playlist" root node, holding the play queue.
+// If it can't be found by name (localized VLC), the first root node is returned
+func (p *Playlist) PlaylistNode() *Playlist {
+	return p.rootNode(playlistNodeName, 0)
+}
+
+// MediaLibraryNode returns the "Media Library" root node.
+// If it can't be found by name (localized VLC), the second root node is returned
+func (p *Playlist) MediaLibraryNode() *Playlist {
+	return p.rootNode(mediaLibraryNodeName, 1)
+}
+
+// rootNode returns the direct child node with the given name,
+// falling back to the child node at the given position
+func (p *Playlist) rootNode(name string, position int) *Playlist {
+	nodes := make([]*Playlist, 0, len(p.Children))
+
+	for i := range p.Children {
+		child := &p.Children[i]
+		if !child.IsNode() {
+			continue
+		}
+
+		if child.Name == name {
+			return child
+		}
+
+		nodes = append(nodes, child)
+	}
+
+	if position < len(nodes) {
+		return nodes[position]
+	}
+
+	return nil
+}
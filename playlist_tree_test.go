@@ -0,0 +1,189 @@
+package vlc
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestTree creates a playlist tree with a nested node, and a media library item
+func newTestTree() *Playlist {
+	return &Playlist{
+		ID:   "0",
+		Type: playlistTypeNode,
+		Children: []Playlist{
+			{
+				ID:   "1",
+				Type: playlistTypeNode,
+				Name: playlistNodeName,
+				Children: []Playlist{
+					{ID: "3", Type: playlistTypeLeaf, Name: "intro", URI: "file:///media/intro.mp4"},
+					{
+						ID:   "4",
+						Type: playlistTypeNode,
+						Name: "album",
+						Children: []Playlist{
+							{
+								ID:      "5",
+								Type:    playlistTypeLeaf,
+								Name:    "track 1",
+								URI:     "file:///media/track1.mp3",
+								Current: playlistCurrentFlag,
+							},
+							{ID: "6", Type: playlistTypeLeaf, Name: "track 2", URI: "file:///media/track2.mp3"},
+						},
+					},
+					{ID: "7", Type: playlistTypeLeaf, Name: "intro", URI: "file:///media/intro.mp4"},
+				},
+			},
+			{
+				ID:   "2",
+				Type: playlistTypeNode,
+				Name: mediaLibraryNodeName,
+				Children: []Playlist{
+					{ID: "8", Type: playlistTypeLeaf, Name: "library", URI: "file:///media/library.mp4"},
+				},
+			},
+		},
+	}
+}
+
+// ids extracts the item IDs, in order
+func ids(items []*Playlist) []string {
+	result := make([]string, 0, len(items))
+
+	for _, item := range items {
+		result = append(result, item.ID)
+	}
+
+	return result
+}
+
+func TestPlaylist_Walk(t *testing.T) {
+	t.Parallel()
+
+	t.Run("depth-first with parent paths", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			tree  = newTestTree()
+			order = make([]string, 0)
+			paths = make(map[string][]string)
+		)
+
+		err := tree.Walk(func(item *Playlist, path []*Playlist) error {
+			order = append(order, item.ID)
+			paths[item.ID] = ids(path)
+
+			return nil
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"0", "1", "3", "4", "5", "6", "7", "2", "8"}, order)
+		assert.Equal(t, []string{}, paths["0"])
+		assert.Equal(t, []string{"0", "1", "4"}, paths["6"])
+		assert.Equal(t, []string{"0", "2"}, paths["8"])
+	})
+
+	t.Run("children are skipped", func(t *testing.T) {
+		t.Parallel()
+
+		order := make([]string, 0)
+
+		err := newTestTree().Walk(func(item *Playlist, _ []*Playlist) error {
+			order = append(order, item.ID)
+
+			if item.Name == "album" {
+				return ErrSkipChildren
+			}
+
+			return nil
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"0", "1", "3", "4", "7", "2", "8"}, order)
+	})
+
+	t.Run("walk is stopped", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			stopErr = errors.New("stop")
+			order   = make([]string, 0)
+		)
+
+		err := newTestTree().Walk(func(item *Playlist, _ []*Playlist) error {
+			order = append(order, item.ID)
+
+			if item.ID == "4" {
+				return stopErr
+			}
+
+			return nil
+		})
+
+		assert.ErrorIs(t, err, stopErr)
+		assert.Equal(t, []string{"0", "1", "3", "4"}, order)
+	})
+}
+
+func TestPlaylist_Find(t *testing.T) {
+	t.Parallel()
+
+	tree := newTestTree()
+
+	assert.Equal(t, []string{"3", "5", "6", "7", "8"}, ids(tree.Leaves()))
+
+	assert.Equal(t, "track 2", tree.FindByID("6").Name)
+	assert.Nil(t, tree.FindByID("100"))
+
+	assert.Equal(t, []string{"3", "7"}, ids(tree.FindByURI("file:///media/intro.mp4")))
+	assert.Equal(t, []string{"3", "7"}, ids(tree.FindByName("intro")))
+	assert.Empty(t, tree.FindByName("missing"))
+
+	current := tree.CurrentItem()
+	require.NotNil(t, current)
+
+	assert.Equal(t, "5", current.ID)
+
+	id, err := current.ItemID()
+	require.NoError(t, err)
+
+	assert.Equal(t, 5, id)
+}
+
+func TestPlaylist_RootNodes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nodes found by name", func(t *testing.T) {
+		t.Parallel()
+
+		tree := newTestTree()
+
+		assert.Equal(t, "1", tree.PlaylistNode().ID)
+		assert.Equal(t, "2", tree.MediaLibraryNode().ID)
+	})
+
+	t.Run("localized nodes found by position", func(t *testing.T) {
+		t.Parallel()
+
+		tree := newTestTree()
+		tree.Children[0].Name = "Liste de lecture"
+		tree.Children[1].Name = "Médiathèque"
+
+		assert.Equal(t, "1", tree.PlaylistNode().ID)
+		assert.Equal(t, "2", tree.MediaLibraryNode().ID)
+	})
+
+	t.Run("no current item or nodes", func(t *testing.T) {
+		t.Parallel()
+
+		empty := &Playlist{ID: "0", Type: playlistTypeNode}
+
+		assert.Nil(t, empty.CurrentItem())
+		assert.Nil(t, empty.PlaylistNode())
+		assert.Nil(t, empty.MediaLibraryNode())
+	})
+}
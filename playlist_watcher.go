@@ -0,0 +1,299 @@
+package vlc
+
+import (
+	"context"
+	"time"
+)
+
+// PlaylistEventType is the type of the playlist change
+type PlaylistEventType int
+
+const (
+	ItemAdded          PlaylistEventType = iota // the item was added to the playlist
+	ItemRemoved                                 // the item was removed from the playlist
+	ItemMoved                                   // the item was reordered, or moved to a different parent node
+	CurrentItemChanged                          // the current item changed
+)
+
+// playlistEventNames are the string representations of the playlist event types
+var playlistEventNames = map[PlaylistEventType]string{
+	ItemAdded:          "item added",
+	ItemRemoved:        "item removed",
+	ItemMoved:          "item moved",
+	CurrentItemChanged: "current item changed",
+}
+
+// String returns the string representation of the playlist event type
+func (t PlaylistEventType) String() string {
+	if name, ok := playlistEventNames[t]; ok {
+		return name
+	}
+
+	return "unknown"
+}
+
+// PlaylistEvent is a single structural change between two consecutive playlists
+type PlaylistEvent struct {
+	// Item is the added, removed, moved or current item.
+	// It is nil for CurrentItemChanged, if there is no current item
+	Item *Playlist
+
+	// Previous is the previous current item, for CurrentItemChanged
+	Previous *Playlist
+
+	ParentID         string // the parent node ID
+	PreviousParentID string // the previous parent node ID, for ItemMoved
+
+	Index         int // the index within the parent node
+	PreviousIndex int // the previous index within the parent node, for ItemMoved
+
+	Type PlaylistEventType
+}
+
+// PlaylistWatcher polls the VLC playlist, and emits the structural changes between consecutive playlists.
+// Items are matched by their ID
+type PlaylistWatcher struct {
+	emitter[PlaylistEvent]
+
+	vlc *VLC
+	cfg *watcherConfig
+}
+
+// NewPlaylistWatcher creates a new playlist watcher for the given VLC instance
+func NewPlaylistWatcher(vlc *VLC, opts ...WatcherOption) *PlaylistWatcher {
+	return &PlaylistWatcher{
+		vlc: vlc,
+		cfg: newWatcherConfig(opts),
+	}
+}
+
+// Run polls the playlist, and emits events until the context is done
+func (w *PlaylistWatcher) Run(ctx context.Context) {
+	sub := w.subscribe()
+	defer sub.close()
+
+	var previous *Playlist
+
+	w.cfg.poll(ctx, func(ctx context.Context, _ time.Time) {
+		current, err := w.vlc.GetPlaylistContext(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				w.cfg.onError(err)
+			}
+
+			return
+		}
+
+		if previous != nil {
+			for _, event := range diffPlaylist(previous, current) {
+				if !sub.emit(ctx, event) {
+					return
+				}
+			}
+		}
+
+		previous = current
+	})
+}
+
+// playlistEntry is a single indexed playlist node
+type playlistEntry struct {
+	item     *Playlist
+	parentID string
+	index    int
+}
+
+// playlistIndex is the playlist tree, indexed by node ID
+type playlistIndex struct {
+	entries  map[string]playlistEntry
+	children map[string][]string // parent ID -> ordered child IDs
+	order    []string            // depth-first order
+	current  *Playlist
+}
+
+// newPlaylistIndex indexes the given playlist tree
+func newPlaylistIndex(root *Playlist) *playlistIndex {
+	index := &playlistIndex{
+		entries:  make(map[string]playlistEntry),
+		children: make(map[string][]string),
+	}
+
+	var walk func(node *Playlist, parentID string, position int)
+
+	walk = func(node *Playlist, parentID string, position int) {
+		index.entries[node.ID] = playlistEntry{
+			item:     node,
+			parentID: parentID,
+			index:    position,
+		}
+
+		index.order = append(index.order, node.ID)
+		index.children[parentID] = append(index.children[parentID], node.ID)
+
+		if node.IsCurrent() {
+			index.current = node
+		}
+
+		for i := range node.Children {
+			walk(&node.Children[i], node.ID, i)
+		}
+	}
+
+	walk(root, "", 0)
+
+	return index
+}
+
+// diffPlaylist returns the structural changes between the previous and current playlist
+func diffPlaylist(previous, current *Playlist) []PlaylistEvent {
+	var (
+		events = make([]PlaylistEvent, 0)
+
+		prev = newPlaylistIndex(previous)
+		curr = newPlaylistIndex(current)
+	)
+
+	// Removed items, in the previous order
+	for _, id := range prev.order {
+		if _, ok := curr.entries[id]; ok {
+			continue
+		}
+
+		entry := prev.entries[id]
+
+		events = append(events, PlaylistEvent{
+			Type:     ItemRemoved,
+			Item:     entry.item,
+			ParentID: entry.parentID,
+			Index:    entry.index,
+		})
+	}
+
+	moved := movedItems(prev, curr)
+
+	// Added and moved items, in the current order
+	for _, id := range curr.order {
+		entry := curr.entries[id]
+
+		prevEntry, existed := prev.entries[id]
+
+		switch {
+		case !existed:
+			events = append(events, PlaylistEvent{
+				Type:     ItemAdded,
+				Item:     entry.item,
+				ParentID: entry.parentID,
+				Index:    entry.index,
+			})
+		case moved[id]:
+			events = append(events, PlaylistEvent{
+				Type:             ItemMoved,
+				Item:             entry.item,
+				ParentID:         entry.parentID,
+				PreviousParentID: prevEntry.parentID,
+				Index:            entry.index,
+				PreviousIndex:    prevEntry.index,
+			})
+		}
+	}
+
+	if currentID(prev.current) != currentID(curr.current) {
+		events = append(events, PlaylistEvent{
+			Type:     CurrentItemChanged,
+			Item:     curr.current,
+			Previous: prev.current,
+		})
+	}
+
+	return events
+}
+
+// movedItems returns the IDs of the items that changed their parent,
+// or their relative order among the siblings present in both playlists.
+// The minimal set of moves is found using the longest common subsequence
+func movedItems(prev, curr *playlistIndex) map[string]bool {
+	moved := make(map[string]bool)
+
+	for parentID, currChildren := range curr.children {
+		var (
+			prevOrder = make([]string, 0, len(currChildren))
+			currOrder = make([]string, 0, len(currChildren))
+		)
+
+		for _, id := range currChildren {
+			prevEntry, ok := prev.entries[id]
+			if !ok {
+				continue
+			}
+
+			if prevEntry.parentID != parentID {
+				moved[id] = true
+
+				continue
+			}
+
+			currOrder = append(currOrder, id)
+		}
+
+		for _, id := range prev.children[parentID] {
+			if entry, ok := curr.entries[id]; ok && entry.parentID == parentID {
+				prevOrder = append(prevOrder, id)
+			}
+		}
+
+		stable := longestCommonSubsequence(prevOrder, currOrder)
+
+		for _, id := range currOrder {
+			if !stable[id] {
+				moved[id] = true
+			}
+		}
+	}
+
+	return moved
+}
+
+// longestCommonSubsequence returns the set of elements in the
+// longest common subsequence of the two sequences
+func longestCommonSubsequence(a, b []string) map[string]bool {
+	lengths := make([][]int, len(a)+1)
+	for i := range lengths {
+		lengths[i] = make([]int, len(b)+1)
+	}
+
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else {
+				lengths[i][j] = max(lengths[i+1][j], lengths[i][j+1])
+			}
+		}
+	}
+
+	common := make(map[string]bool, lengths[0][0])
+
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		switch {
+		case a[i] == b[j]:
+			common[a[i]] = true
+			i++
+			j++
+		case lengths[i+1][j] >= lengths[i][j+1]:
+			i++
+		default:
+			j++
+		}
+	}
+
+	return common
+}
+
+// currentID returns the ID of the current item, if any
+func currentID(item *Playlist) string {
+	if item == nil {
+		return ""
+	}
+
+	return item.ID
+}
@@ -0,0 +1,222 @@
+package vlc
+
+import (
+	"context"
+	"encoding/json"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestPlaylist creates a playlist tree with the given
+// leaf IDs in the "Playlist" node, and the current leaf ID
+func newTestPlaylist(currentID string, ids ...string) *Playlist {
+	leaves := make([]Playlist, 0, len(ids))
+
+	for _, id := range ids {
+		leaf := Playlist{
+			ID:   id,
+			Type: "leaf",
+			Name: "item " + id,
+			URI:  "file:///media/" + id + ".mp4",
+		}
+
+		if id == currentID {
+			leaf.Current = "current"
+		}
+
+		leaves = append(leaves, leaf)
+	}
+
+	return &Playlist{
+		ID:   "0",
+		Type: "node",
+		Children: []Playlist{
+			{
+				ID:       "1",
+				Type:     "node",
+				Name:     "Playlist",
+				Children: leaves,
+			},
+			{
+				ID:   "2",
+				Type: "node",
+				Name: "Media Library",
+			},
+		},
+	}
+}
+
+// playlistEventSummary is a compact event representation, for assertions
+type playlistEventSummary struct {
+	Type  PlaylistEventType
+	ID    string
+	Index int
+}
+
+// summarizePlaylistEvents converts the events into their summaries
+func summarizePlaylistEvents(events []PlaylistEvent) []playlistEventSummary {
+	summaries := make([]playlistEventSummary, 0, len(events))
+
+	for _, event := range events {
+		summaries = append(summaries, playlistEventSummary{
+			Type:  event.Type,
+			ID:    currentID(event.Item),
+			Index: event.Index,
+		})
+	}
+
+	return summaries
+}
+
+func TestDiffPlaylist(t *testing.T) {
+	t.Parallel()
+
+	testTable := []struct {
+		name     string
+		previous *Playlist
+		current  *Playlist
+		expected []playlistEventSummary
+	}{
+		{
+			"unchanged",
+			newTestPlaylist("4", "3", "4", "5"),
+			newTestPlaylist("4", "3", "4", "5"),
+			[]playlistEventSummary{},
+		},
+		{
+			"item added",
+			newTestPlaylist("", "3", "4"),
+			newTestPlaylist("", "3", "6", "4"),
+			[]playlistEventSummary{
+				{Type: ItemAdded, ID: "6", Index: 1},
+			},
+		},
+		{
+			"item removed",
+			newTestPlaylist("", "3", "4", "5"),
+			newTestPlaylist("", "3", "5"),
+			[]playlistEventSummary{
+				{Type: ItemRemoved, ID: "4", Index: 1},
+			},
+		},
+		{
+			"item moved to the front",
+			newTestPlaylist("", "3", "4", "5", "6"),
+			newTestPlaylist("", "6", "3", "4", "5"),
+			[]playlistEventSummary{
+				{Type: ItemMoved, ID: "6", Index: 0},
+			},
+		},
+		{
+			"current item changed",
+			newTestPlaylist("3", "3", "4"),
+			newTestPlaylist("4", "3", "4"),
+			[]playlistEventSummary{
+				{Type: CurrentItemChanged, ID: "4"},
+			},
+		},
+		{
+			"current item removed",
+			newTestPlaylist("3", "3", "4"),
+			newTestPlaylist("", "4"),
+			[]playlistEventSummary{
+				{Type: ItemRemoved, ID: "3", Index: 0},
+				{Type: CurrentItemChanged, ID: ""},
+			},
+		},
+	}
+
+	for _, testCase := range testTable {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			events := diffPlaylist(testCase.previous, testCase.current)
+
+			assert.Equal(t, testCase.expected, summarizePlaylistEvents(events))
+		})
+	}
+}
+
+func TestDiffPlaylist_ParentChanged(t *testing.T) {
+	t.Parallel()
+
+	var (
+		previous = newTestPlaylist("", "3", "4")
+		current  = newTestPlaylist("", "3")
+	)
+
+	// Move item 4 to the media library
+	current.Children[1].Children = []Playlist{previous.Children[0].Children[1]}
+
+	events := diffPlaylist(previous, current)
+	require.Len(t, events, 1)
+
+	assert.Equal(t, ItemMoved, events[0].Type)
+	assert.Equal(t, "4", events[0].Item.ID)
+	assert.Equal(t, "1", events[0].PreviousParentID)
+	assert.Equal(t, "2", events[0].ParentID)
+	assert.Equal(t, 1, events[0].PreviousIndex)
+	assert.Equal(t, 0, events[0].Index)
+}
+
+func TestPlaylistWatcher_Run(t *testing.T) {
+	t.Parallel()
+
+	var (
+		calls     atomic.Int64
+		playlists = []*Playlist{
+			newTestPlaylist("3", "3"),
+			newTestPlaylist("3", "3", "4"),
+		}
+
+		mockClient = &mockClient{
+			getFn: func(endpoint string) ([]byte, error) {
+				require.Equal(t, basePlaylist, endpoint)
+
+				index := int(calls.Add(1) - 1)
+				if index >= len(playlists) {
+					index = len(playlists) - 1
+				}
+
+				return json.Marshal(playlists[index])
+			},
+		}
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var (
+		watcher = NewPlaylistWatcher(NewVLC(mockClient), WithPollInterval(time.Millisecond))
+		events  = watcher.Events()
+		done    = make(chan struct{})
+	)
+
+	go func() {
+		defer close(done)
+
+		watcher.Run(ctx)
+	}()
+
+	event := <-events
+
+	assert.Equal(t, ItemAdded, event.Type)
+	assert.Equal(t, "4", event.Item.ID)
+	assert.Equal(t, "1", event.ParentID)
+
+	cancel()
+	<-done
+}
+
+func TestPlaylistEventType_String(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "item moved", ItemMoved.String())
+	assert.Equal(t, "unknown", PlaylistEventType(-1).String())
+}
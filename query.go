@@ -5,35 +5,65 @@ import (
 	"strings"
 )
 
+// queryParams are query parameters, which can be single or multi-valued
+type queryParams interface {
+	// values returns the parameter values, by key
+	values() map[string][]string
+}
+
+// paramMap holds single-valued query parameters
 type paramMap map[string]string
 
-// buildQueryEndpoint constructs the query string from the given parameters
-func buildQueryEndpoint(baseURL string, params paramMap) string {
+// values returns the parameter values, by key
+func (p paramMap) values() map[string][]string {
+	values := make(map[string][]string, len(p))
+
+	for key, value := range p {
+		values[key] = []string{value}
+	}
+
+	return values
+}
+
+// multiParamMap holds query parameters that can be repeated (key=a&key=b)
+type multiParamMap map[string][]string
+
+// values returns the parameter values, by key
+func (p multiParamMap) values() map[string][]string {
+	return p
+}
+
+// buildQueryEndpoint constructs the query string from the given parameters.
+// Keys and values are percent-encoded (RFC 3986), and repeated keys keep the order of their values
+func buildQueryEndpoint(baseURL string, params queryParams) string {
 	// Check if there are any parameters to add
-	if len(params) == 0 {
+	if params == nil {
+		return baseURL
+	}
+
+	values := params.values()
+	if len(values) == 0 {
 		return baseURL
 	}
 
 	// Sort the map keys, so every
 	// query endpoint output is deterministic
-	keys := make([]string, 0, len(params))
-	for k := range params {
+	keys := make([]string, 0, len(values))
+	for k := range values {
 		keys = append(keys, k)
 	}
 
 	sort.Strings(keys)
 
 	// Build the query string
-	queryParams := make([]string, 0, len(params))
+	queryParams := make([]string, 0, len(values))
 
 	for _, key := range keys {
-		value := params[key]
-
-		// Manually escape the parameters
-		escapedKey := strings.ReplaceAll(key, " ", "%20")
-		escapedValue := strings.ReplaceAll(value, " ", "%20")
+		escapedKey := escapeQueryComponent(key)
 
-		queryParams = append(queryParams, escapedKey+"="+escapedValue)
+		for _, value := range values[key] {
+			queryParams = append(queryParams, escapedKey+"="+escapeQueryComponent(value))
+		}
 	}
 
 	queryString := strings.Join(queryParams, "&")
@@ -41,3 +71,41 @@ func buildQueryEndpoint(baseURL string, params paramMap) string {
 	// Return the query string prefixed with '?'
 	return baseURL + "?" + queryString
 }
+
+// escapeQueryComponent percent-encodes everything but the RFC 3986 unreserved characters.
+// Unlike url.QueryEscape, spaces are encoded as %20, since VLC doesn't decode '+' into a space
+func escapeQueryComponent(s string) string {
+	const upperHex = "0123456789ABCDEF"
+
+	var builder strings.Builder
+
+	builder.Grow(len(s))
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+
+		if isUnreserved(c) {
+			builder.WriteByte(c)
+
+			continue
+		}
+
+		builder.WriteByte('%')
+		builder.WriteByte(upperHex[c>>4])
+		builder.WriteByte(upperHex[c&0x0F])
+	}
+
+	return builder.String()
+}
+
+// isUnreserved checks if the byte is an RFC 3986 unreserved character
+func isUnreserved(c byte) bool {
+	switch {
+	case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9':
+		return true
+	case c == '-', c == '.', c == '_', c == '~':
+		return true
+	default:
+		return false
+	}
+}
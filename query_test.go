@@ -74,4 +74,66 @@ func TestBuildQueryEndpoint(t *testing.T) {
 
 		assert.Equal(t, expectedURL, endpoint)
 	})
+
+	t.Run("reserved and non-ASCII characters", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			baseURL = "https://example.com"
+			params  = paramMap{
+				"input": "file:///media/Tom & Jerry #1 (50% off) + más?.mkv",
+			}
+
+			expectedURL = baseURL + "?input=file%3A%2F%2F%2Fmedia%2FTom%20%26%20Jerry%20%231%20%2850%25%20off%29" +
+				"%20%2B%20m%C3%A1s%3F.mkv"
+		)
+
+		endpoint := buildQueryEndpoint(baseURL, params)
+
+		assert.Equal(t, expectedURL, endpoint)
+	})
+
+	t.Run("unreserved characters", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			baseURL = "https://example.com"
+			params  = paramMap{
+				"key": "aZ09-._~",
+			}
+
+			expectedURL = baseURL + "?key=aZ09-._~"
+		)
+
+		endpoint := buildQueryEndpoint(baseURL, params)
+
+		assert.Equal(t, expectedURL, endpoint)
+	})
+
+	t.Run("repeated keys", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			baseURL = "https://example.com"
+			params  = multiParamMap{
+				"option":  {":start-time=10", ":no-audio"},
+				"command": {"in_play"},
+			}
+
+			expectedURL = baseURL + "?command=in_play&option=%3Astart-time%3D10&option=%3Ano-audio"
+		)
+
+		endpoint := buildQueryEndpoint(baseURL, params)
+
+		assert.Equal(t, expectedURL, endpoint)
+	})
+
+	t.Run("empty params", func(t *testing.T) {
+		t.Parallel()
+
+		baseURL := "https://example.com"
+
+		assert.Equal(t, baseURL, buildQueryEndpoint(baseURL, paramMap{}))
+		assert.Equal(t, baseURL, buildQueryEndpoint(baseURL, multiParamMap(nil)))
+	})
 }
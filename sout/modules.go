@@ -0,0 +1,556 @@
+package sout
+
+import (
+	"fmt"
+)
+
+// Module names
+const (
+	transcodeModule = "transcode"
+	stdModule       = "std"
+	duplicateModule = "duplicate"
+	displayModule   = "display"
+	esModule        = "es"
+	rtpModule       = "rtp"
+	liveHTTPModule  = "livehttp"
+)
+
+// Transcode transcodes the audio, video and subtitle streams
+type Transcode struct {
+	VideoCodec    string   // vcodec, such as h264, mp2v, theo
+	VideoEncoder  string   // venc, such as x264{profile=baseline}
+	AudioCodec    string   // acodec, such as mp4a, mpga, vorb
+	AudioEncoder  string   // aenc
+	SubtitleCodec string   // scodec
+	Extra         []Option // options without a typed field
+	VideoBitrate  int      // vb, in kb/s
+	Width         int      // width
+	Height        int      // height
+	MaxWidth      int      // maxwidth
+	MaxHeight     int      // maxheight
+	AudioBitrate  int      // ab, in kb/s
+	Channels      int      // channels
+	SampleRate    int      // samplerate, in Hz
+	Threads       int      // threads
+	Scale         float64  // scale
+	FPS           float64  // fps
+	Deinterlace   bool     // deinterlace
+	SubOverlay    bool     // soverlay
+	AudioSync     bool     // audio-sync
+}
+
+// Name returns the module name
+func (t *Transcode) Name() string {
+	return transcodeModule
+}
+
+// Options returns the module options
+func (t *Transcode) Options() []Option {
+	b := &optionsBuilder{}
+
+	b.str("vcodec", t.VideoCodec)
+	b.str("venc", t.VideoEncoder)
+	b.int("vb", t.VideoBitrate)
+	b.float("scale", t.Scale)
+	b.float("fps", t.FPS)
+	b.int("width", t.Width)
+	b.int("height", t.Height)
+	b.int("maxwidth", t.MaxWidth)
+	b.int("maxheight", t.MaxHeight)
+	b.flag("deinterlace", t.Deinterlace)
+	b.str("acodec", t.AudioCodec)
+	b.str("aenc", t.AudioEncoder)
+	b.int("ab", t.AudioBitrate)
+	b.int("channels", t.Channels)
+	b.int("samplerate", t.SampleRate)
+	b.flag("audio-sync", t.AudioSync)
+	b.str("scodec", t.SubtitleCodec)
+	b.flag("soverlay", t.SubOverlay)
+	b.int("threads", t.Threads)
+
+	return b.build(t.Extra)
+}
+
+// parseTranscode parses the transcode module options.
+// Values VLC accepts but the typed fields can't hold, such as vb=800k or scale=Auto, are kept in Extra
+func parseTranscode(options []Option) *Transcode {
+	t := &Transcode{}
+
+	for _, option := range options {
+		if option.explicitEmpty() {
+			t.Extra = append(t.Extra, option)
+
+			continue
+		}
+
+		var err error
+
+		switch option.Key {
+		case "vcodec":
+			t.VideoCodec = option.Value
+		case "venc":
+			t.VideoEncoder = option.Value
+		case "vb":
+			t.VideoBitrate, err = parseInt(option)
+		case "scale":
+			t.Scale, err = parseFloat(option)
+		case "fps":
+			t.FPS, err = parseFloat(option)
+		case "width":
+			t.Width, err = parseInt(option)
+		case "height":
+			t.Height, err = parseInt(option)
+		case "maxwidth":
+			t.MaxWidth, err = parseInt(option)
+		case "maxheight":
+			t.MaxHeight, err = parseInt(option)
+		case "deinterlace":
+			t.Deinterlace, err = parseFlag(option)
+		case "acodec":
+			t.AudioCodec = option.Value
+		case "aenc":
+			t.AudioEncoder = option.Value
+		case "ab":
+			t.AudioBitrate, err = parseInt(option)
+		case "channels":
+			t.Channels, err = parseInt(option)
+		case "samplerate":
+			t.SampleRate, err = parseInt(option)
+		case "audio-sync":
+			t.AudioSync, err = parseFlag(option)
+		case "scodec":
+			t.SubtitleCodec = option.Value
+		case "soverlay":
+			t.SubOverlay, err = parseFlag(option)
+		case "threads":
+			t.Threads, err = parseInt(option)
+		default:
+			t.Extra = append(t.Extra, option)
+		}
+
+		if err != nil {
+			t.Extra = append(t.Extra, option)
+		}
+	}
+
+	return t
+}
+
+// Std outputs the stream using an access output and a muxer
+type Std struct {
+	Access string   // access, such as file, http, udp, or a nested module, such as livehttp{...}
+	Mux    string   // mux, such as ts, ps, mp4, ogg
+	Dst    string   // dst, the destination path or address
+	Extra  []Option // options without a typed field
+}
+
+// Name returns the module name
+func (s *Std) Name() string {
+	return stdModule
+}
+
+// Options returns the module options
+func (s *Std) Options() []Option {
+	b := &optionsBuilder{}
+
+	b.str("access", s.Access)
+	b.str("mux", s.Mux)
+	b.str("dst", s.Dst)
+
+	return b.build(s.Extra)
+}
+
+// parseStd parses the std module options
+func parseStd(options []Option) *Std {
+	s := &Std{}
+
+	for _, option := range options {
+		if option.explicitEmpty() {
+			s.Extra = append(s.Extra, option)
+
+			continue
+		}
+
+		switch option.Key {
+		case "access":
+			s.Access = option.Value
+		case "mux":
+			s.Mux = option.Value
+		case "dst":
+			s.Dst = option.Value
+		default:
+			s.Extra = append(s.Extra, option)
+		}
+	}
+
+	return s
+}
+
+// Destination is a single duplicate module destination
+type Destination struct {
+	Select string // select, the elementary stream selection, such as "video" or "es=1"
+	Chain  Chain  // dst, the destination chain
+}
+
+// Duplicate sends the stream to multiple destination chains
+type Duplicate struct {
+	Destinations []Destination
+}
+
+// Name returns the module name
+func (d *Duplicate) Name() string {
+	return duplicateModule
+}
+
+// Options returns the module options
+func (d *Duplicate) Options() []Option {
+	b := &optionsBuilder{}
+
+	for _, destination := range d.Destinations {
+		b.str("dst", destination.Chain.render())
+		b.str("select", destination.Select)
+	}
+
+	return b.options
+}
+
+// parseDuplicate parses the duplicate module options.
+// The select option applies to the preceding destination
+func parseDuplicate(options []Option) (*Duplicate, error) {
+	d := &Duplicate{}
+
+	for _, option := range options {
+		switch option.Key {
+		case "dst":
+			chain, err := Parse(option.Value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid destination, %w", err)
+			}
+
+			d.Destinations = append(d.Destinations, Destination{Chain: chain})
+		case "select":
+			if len(d.Destinations) == 0 {
+				return nil, fmt.Errorf("select without a destination, %s", option.Value)
+			}
+
+			d.Destinations[len(d.Destinations)-1].Select = option.Value
+		default:
+			return nil, fmt.Errorf("unknown duplicate option, %s", option.Key)
+		}
+	}
+
+	return d, nil
+}
+
+// Display plays the stream locally
+type Display struct {
+	Extra   []Option // options without a typed field
+	Delay   int      // delay, in milliseconds
+	NoAudio bool     // noaudio
+	NoVideo bool     // novideo
+}
+
+// Name returns the module name
+func (d *Display) Name() string {
+	return displayModule
+}
+
+// Options returns the module options
+func (d *Display) Options() []Option {
+	b := &optionsBuilder{}
+
+	b.flag("noaudio", d.NoAudio)
+	b.flag("novideo", d.NoVideo)
+	b.int("delay", d.Delay)
+
+	return b.build(d.Extra)
+}
+
+// parseDisplay parses the display module options.
+// Unparsable values are kept in Extra
+func parseDisplay(options []Option) *Display {
+	d := &Display{}
+
+	for _, option := range options {
+		if option.explicitEmpty() {
+			d.Extra = append(d.Extra, option)
+
+			continue
+		}
+
+		var err error
+
+		switch option.Key {
+		case "noaudio":
+			d.NoAudio, err = parseFlag(option)
+		case "novideo":
+			d.NoVideo, err = parseFlag(option)
+		case "delay":
+			d.Delay, err = parseInt(option)
+		default:
+			d.Extra = append(d.Extra, option)
+		}
+
+		if err != nil {
+			d.Extra = append(d.Extra, option)
+		}
+	}
+
+	return d
+}
+
+// ES outputs each elementary stream separately
+type ES struct {
+	Access      string   // access
+	AccessAudio string   // access-audio
+	AccessVideo string   // access-video
+	Mux         string   // mux
+	MuxAudio    string   // mux-audio
+	MuxVideo    string   // mux-video
+	Dst         string   // dst
+	DstAudio    string   // dst-audio
+	DstVideo    string   // dst-video
+	Extra       []Option // options without a typed field
+}
+
+// Name returns the module name
+func (e *ES) Name() string {
+	return esModule
+}
+
+// Options returns the module options
+func (e *ES) Options() []Option {
+	b := &optionsBuilder{}
+
+	b.str("access", e.Access)
+	b.str("access-audio", e.AccessAudio)
+	b.str("access-video", e.AccessVideo)
+	b.str("mux", e.Mux)
+	b.str("mux-audio", e.MuxAudio)
+	b.str("mux-video", e.MuxVideo)
+	b.str("dst", e.Dst)
+	b.str("dst-audio", e.DstAudio)
+	b.str("dst-video", e.DstVideo)
+
+	return b.build(e.Extra)
+}
+
+// parseES parses the es module options
+func parseES(options []Option) *ES {
+	e := &ES{}
+
+	fields := map[string]*string{
+		"access":       &e.Access,
+		"access-audio": &e.AccessAudio,
+		"access-video": &e.AccessVideo,
+		"mux":          &e.Mux,
+		"mux-audio":    &e.MuxAudio,
+		"mux-video":    &e.MuxVideo,
+		"dst":          &e.Dst,
+		"dst-audio":    &e.DstAudio,
+		"dst-video":    &e.DstVideo,
+	}
+
+	for _, option := range options {
+		if option.explicitEmpty() {
+			e.Extra = append(e.Extra, option)
+
+			continue
+		}
+
+		field, ok := fields[option.Key]
+		if !ok {
+			e.Extra = append(e.Extra, option)
+
+			continue
+		}
+
+		*field = option.Value
+	}
+
+	return e
+}
+
+// RTP streams over RTP, optionally announcing the session with SDP
+type RTP struct {
+	Dst         string   // dst, the destination address
+	SDP         string   // sdp, such as rtsp://:8554/stream or sap
+	SessionName string   // name, the session name
+	Mux         string   // mux, such as ts
+	Proto       string   // proto, such as udp, dccp, sctp
+	Extra       []Option // options without a typed field
+	Port        int      // port
+	PortAudio   int      // port-audio
+	PortVideo   int      // port-video
+	TTL         int      // ttl
+}
+
+// Name returns the module name
+func (r *RTP) Name() string {
+	return rtpModule
+}
+
+// Options returns the module options
+func (r *RTP) Options() []Option {
+	b := &optionsBuilder{}
+
+	b.str("dst", r.Dst)
+	b.int("port", r.Port)
+	b.int("port-audio", r.PortAudio)
+	b.int("port-video", r.PortVideo)
+	b.str("sdp", r.SDP)
+	b.str("name", r.SessionName)
+	b.str("mux", r.Mux)
+	b.str("proto", r.Proto)
+	b.int("ttl", r.TTL)
+
+	return b.build(r.Extra)
+}
+
+// parseRTP parses the rtp module options.
+// Unparsable values are kept in Extra
+func parseRTP(options []Option) *RTP {
+	r := &RTP{}
+
+	for _, option := range options {
+		if option.explicitEmpty() {
+			r.Extra = append(r.Extra, option)
+
+			continue
+		}
+
+		var err error
+
+		switch option.Key {
+		case "dst":
+			r.Dst = option.Value
+		case "port":
+			r.Port, err = parseInt(option)
+		case "port-audio":
+			r.PortAudio, err = parseInt(option)
+		case "port-video":
+			r.PortVideo, err = parseInt(option)
+		case "sdp":
+			r.SDP = option.Value
+		case "name":
+			r.SessionName = option.Value
+		case "mux":
+			r.Mux = option.Value
+		case "proto":
+			r.Proto = option.Value
+		case "ttl":
+			r.TTL, err = parseInt(option)
+		default:
+			r.Extra = append(r.Extra, option)
+		}
+
+		if err != nil {
+			r.Extra = append(r.Extra, option)
+		}
+	}
+
+	return r
+}
+
+// LiveHTTP is the HTTP Live Streaming (HLS) access output,
+// used as the std module access:
+//
+//	std{access=livehttp{seglen=10,index=/srv/stream.m3u8,index-url=stream-########.ts},mux=ts{use-key-frames},dst=/srv/stream-########.ts}
+type LiveHTTP struct {
+	Index         string   // index, the playlist file path
+	IndexURL      string   // index-url, the segment URL template
+	Extra         []Option // options without a typed field
+	SegmentLength int      // seglen, in seconds
+	NumSegments   int      // numsegs, the number of segments in the index
+	DeleteSegs    bool     // delsegs
+	RateControl   bool     // ratecontrol
+	SplitAnywhere bool     // splitanywhere
+}
+
+// Name returns the module name
+func (l *LiveHTTP) Name() string {
+	return liveHTTPModule
+}
+
+// Options returns the module options
+func (l *LiveHTTP) Options() []Option {
+	b := &optionsBuilder{}
+
+	b.int("seglen", l.SegmentLength)
+	b.int("numsegs", l.NumSegments)
+	b.flag("delsegs", l.DeleteSegs)
+	b.str("index", l.Index)
+	b.str("index-url", l.IndexURL)
+	b.flag("ratecontrol", l.RateControl)
+	b.flag("splitanywhere", l.SplitAnywhere)
+
+	return b.build(l.Extra)
+}
+
+// String renders the module, so it can be used as the std access
+func (l *LiveHTTP) String() string {
+	return Render(l)
+}
+
+// parseLiveHTTP parses the livehttp module options.
+// Unparsable values are kept in Extra
+func parseLiveHTTP(options []Option) *LiveHTTP {
+	l := &LiveHTTP{}
+
+	for _, option := range options {
+		if option.explicitEmpty() {
+			l.Extra = append(l.Extra, option)
+
+			continue
+		}
+
+		var err error
+
+		switch option.Key {
+		case "seglen":
+			l.SegmentLength, err = parseInt(option)
+		case "numsegs":
+			l.NumSegments, err = parseInt(option)
+		case "delsegs":
+			l.DeleteSegs, err = parseFlag(option)
+		case "index":
+			l.Index = option.Value
+		case "index-url":
+			l.IndexURL = option.Value
+		case "ratecontrol":
+			l.RateControl, err = parseFlag(option)
+		case "splitanywhere":
+			l.SplitAnywhere, err = parseFlag(option)
+		default:
+			l.Extra = append(l.Extra, option)
+		}
+
+		if err != nil {
+			l.Extra = append(l.Extra, option)
+		}
+	}
+
+	return l
+}
+
+// typed converts the generic module into its typed model, if there is one
+func typed(generic *Generic) (Module, error) {
+	switch generic.ModuleName {
+	case transcodeModule:
+		return parseTranscode(generic.ModuleOptions), nil
+	case stdModule:
+		return parseStd(generic.ModuleOptions), nil
+	case duplicateModule:
+		return parseDuplicate(generic.ModuleOptions)
+	case displayModule:
+		return parseDisplay(generic.ModuleOptions), nil
+	case esModule:
+		return parseES(generic.ModuleOptions), nil
+	case rtpModule:
+		return parseRTP(generic.ModuleOptions), nil
+	case liveHTTPModule:
+		return parseLiveHTTP(generic.ModuleOptions), nil
+	default:
+		return generic, nil
+	}
+}
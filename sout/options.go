@@ -0,0 +1,79 @@
+package sout
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// optionsBuilder collects the non-zero module options, in order
+type optionsBuilder struct {
+	options []Option
+}
+
+// str adds a string option, if set
+func (b *optionsBuilder) str(key, value string) {
+	if value != "" {
+		b.options = append(b.options, Option{Key: key, Value: value})
+	}
+}
+
+// int adds an integer option, if set
+func (b *optionsBuilder) int(key string, value int) {
+	if value != 0 {
+		b.options = append(b.options, Option{Key: key, Value: strconv.Itoa(value)})
+	}
+}
+
+// float adds a float option, if set
+func (b *optionsBuilder) float(key string, value float64) {
+	if value != 0 {
+		b.options = append(b.options, Option{Key: key, Value: strconv.FormatFloat(value, 'f', -1, 64)})
+	}
+}
+
+// flag adds a flag option, if set
+func (b *optionsBuilder) flag(key string, value bool) {
+	if value {
+		b.options = append(b.options, Option{Key: key})
+	}
+}
+
+// build returns the collected options, followed by the extra options
+func (b *optionsBuilder) build(extra []Option) []Option {
+	return append(b.options, extra...)
+}
+
+// parseInt parses an integer option value
+func parseInt(option Option) (int, error) {
+	value, err := strconv.Atoi(option.Value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s value, %w", option.Key, err)
+	}
+
+	return value, nil
+}
+
+// parseFloat parses a float option value
+func parseFloat(option Option) (float64, error) {
+	value, err := strconv.ParseFloat(option.Value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s value, %w", option.Key, err)
+	}
+
+	return value, nil
+}
+
+// parseFlag parses a flag option value.
+// Flags without a value are set
+func parseFlag(option Option) (bool, error) {
+	if option.Value == "" {
+		return true, nil
+	}
+
+	value, err := strconv.ParseBool(option.Value)
+	if err != nil {
+		return false, fmt.Errorf("invalid %s value, %w", option.Key, err)
+	}
+
+	return value, nil
+}
@@ -0,0 +1,224 @@
+package sout
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	errEmptyChain       = errors.New("empty chain")
+	errEmptyModule      = errors.New("empty module name")
+	errUnbalancedBraces = errors.New("unbalanced braces")
+	errUnbalancedQuotes = errors.New("unbalanced quotes")
+	errTrailingData     = errors.New("trailing data")
+)
+
+// Parse parses the sout chain, with an optional '#' prefix.
+// Known modules are parsed into their typed models, and unknown ones into *Generic
+func Parse(chain string) (Chain, error) {
+	chain = strings.TrimPrefix(strings.TrimSpace(chain), chainPrefix)
+	if chain == "" {
+		return nil, errEmptyChain
+	}
+
+	var (
+		modules = make(Chain, 0)
+		rest    = chain
+	)
+
+	for {
+		generic, remaining, err := parseModule(rest)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse chain, %q, %w", chain, err)
+		}
+
+		module, err := typed(generic)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse module %s, %w", generic.ModuleName, err)
+		}
+
+		modules = append(modules, module)
+
+		if remaining == "" {
+			return modules, nil
+		}
+
+		if !strings.HasPrefix(remaining, moduleSeparator) {
+			return nil, fmt.Errorf("unable to parse chain, %q, %w", chain, errTrailingData)
+		}
+
+		rest = remaining[len(moduleSeparator):]
+	}
+}
+
+// ParseModule parses a single module, such as livehttp{seglen=10}
+func ParseModule(module string) (Module, error) {
+	generic, remaining, err := parseModule(strings.TrimSpace(module))
+	if err != nil {
+		return nil, err
+	}
+
+	if remaining != "" {
+		return nil, errTrailingData
+	}
+
+	return typed(generic)
+}
+
+// parseModule parses the leading module, and returns the remaining input
+func parseModule(input string) (*Generic, string, error) {
+	nameEnd := strings.IndexAny(input, "{"+moduleSeparator)
+	if nameEnd == -1 {
+		nameEnd = len(input)
+	}
+
+	generic := &Generic{
+		ModuleName: strings.TrimSpace(input[:nameEnd]),
+	}
+
+	if generic.ModuleName == "" {
+		return nil, "", errEmptyModule
+	}
+
+	rest := input[nameEnd:]
+	if !strings.HasPrefix(rest, "{") {
+		return generic, rest, nil
+	}
+
+	rest = rest[1:]
+
+	for {
+		rest = strings.TrimLeft(rest, " ")
+
+		if rest == "" {
+			return nil, "", errUnbalancedBraces
+		}
+
+		if rest[0] == '}' {
+			return generic, rest[1:], nil
+		}
+
+		option, remaining, err := parseOption(rest)
+		if err != nil {
+			return nil, "", err
+		}
+
+		generic.ModuleOptions = append(generic.ModuleOptions, option)
+
+		rest = strings.TrimPrefix(remaining, ",")
+	}
+}
+
+// parseOption parses the leading key[=value] option, and returns the remaining input
+func parseOption(input string) (Option, string, error) {
+	keyEnd := strings.IndexAny(input, "=,}")
+	if keyEnd == -1 {
+		return Option{}, "", errUnbalancedBraces
+	}
+
+	option := Option{
+		Key: strings.TrimSpace(input[:keyEnd]),
+	}
+
+	// Flag option
+	if input[keyEnd] != '=' {
+		return option, input[keyEnd:], nil
+	}
+
+	option.HasValue = true
+
+	rest := input[keyEnd+1:]
+
+	end := valueEnd(rest)
+	if end == -1 {
+		return Option{}, "", errUnbalancedQuotes
+	}
+
+	if end == -2 {
+		return Option{}, "", errUnbalancedBraces
+	}
+
+	option.Value = unquoteValue(strings.TrimSpace(rest[:end]))
+
+	return option, rest[end:], nil
+}
+
+// valueEnd returns the end index of the leading option value, which ends with
+// ',' or '}' outside of nested braces and quotes. It returns -1 for unclosed quotes,
+// and -2 for unclosed braces
+func valueEnd(input string) int {
+	var (
+		depth = 0
+		quote byte
+	)
+
+	for i := 0; i < len(input); i++ {
+		c := input[i]
+
+		if quote != 0 {
+			switch c {
+			case '\\':
+				i++ // skip the escaped character
+			case quote:
+				quote = 0
+			}
+
+			continue
+		}
+
+		switch c {
+		case '"', '\'':
+			quote = c
+		case '{':
+			depth++
+		case '}':
+			if depth == 0 {
+				return i
+			}
+
+			depth--
+		case ',':
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+
+	if quote != 0 {
+		return -1
+	}
+
+	if depth != 0 {
+		return -2
+	}
+
+	return len(input)
+}
+
+// unquoteValue removes the surrounding quotes, and unescapes the value
+func unquoteValue(value string) string {
+	if len(value) < 2 {
+		return value
+	}
+
+	quote := value[0]
+	if (quote != '"' && quote != '\'') || value[len(value)-1] != quote {
+		return value
+	}
+
+	var (
+		inner     = value[1 : len(value)-1]
+		unescaped strings.Builder
+	)
+
+	for i := 0; i < len(inner); i++ {
+		if inner[i] == '\\' && i+1 < len(inner) {
+			i++
+		}
+
+		unescaped.WriteByte(inner[i])
+	}
+
+	return unescaped.String()
+}
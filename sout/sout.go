@@ -0,0 +1,119 @@
+/*
+Package sout models VLC stream output (sout) chains, such as:
+
+	#transcode{vcodec=h264,vb=800,acodec=mp4a}:std{access=http,mux=ts,dst=:8081/x}
+
+Chains are built from typed modules, rendered to the VLC syntax, and parsed back.
+The syntax is outlined here:
+https://wiki.videolan.org/Documentation:Streaming_HowTo/Advanced_Streaming_Using_the_Command_Line/
+*/
+package sout
+
+import (
+	"strings"
+)
+
+const (
+	// chainPrefix is the prefix of a top-level sout chain
+	chainPrefix = "#"
+
+	// moduleSeparator separates chained modules
+	moduleSeparator = ":"
+)
+
+// Module is a single stream output module
+type Module interface {
+	// Name returns the module name
+	Name() string
+
+	// Options returns the module options, in render order
+	Options() []Option
+}
+
+// Option is a single module option.
+// Options with an empty value are rendered as flags (key only), unless HasValue is set
+type Option struct {
+	Key      string
+	Value    string
+	HasValue bool // the option has an explicit value (key=...), even if it's empty
+}
+
+// explicitEmpty checks if the option has an explicitly empty value, such as dst="".
+// Typed fields can't represent it, so it is kept as an extra option
+func (o Option) explicitEmpty() bool {
+	return o.HasValue && o.Value == ""
+}
+
+// Chain is a sequence of stream output modules
+type Chain []Module
+
+// String renders the chain, prefixed with '#'
+func (c Chain) String() string {
+	return chainPrefix + c.render()
+}
+
+// render renders the chain, without the '#' prefix,
+// as used for nested chains (duplicate destinations)
+func (c Chain) render() string {
+	modules := make([]string, 0, len(c))
+
+	for _, module := range c {
+		modules = append(modules, Render(module))
+	}
+
+	return strings.Join(modules, moduleSeparator)
+}
+
+// Render renders a single module, in the form of name{key=value,...}
+func Render(module Module) string {
+	options := module.Options()
+	if len(options) == 0 {
+		return module.Name()
+	}
+
+	rendered := make([]string, 0, len(options))
+
+	for _, option := range options {
+		if option.Value == "" && !option.HasValue {
+			rendered = append(rendered, option.Key)
+
+			continue
+		}
+
+		rendered = append(rendered, option.Key+"="+quoteValue(option.Value))
+	}
+
+	return module.Name() + "{" + strings.Join(rendered, ",") + "}"
+}
+
+// quoteValue quotes the option value, if it can't be parsed back as-is (including empty values).
+// Nested module values, such as x264{profile=baseline}, are kept raw
+func quoteValue(value string) string {
+	raw := value != "" &&
+		value[0] != '"' &&
+		value[0] != '\'' &&
+		strings.TrimSpace(value) == value &&
+		valueEnd(value) == len(value)
+
+	if raw {
+		return value
+	}
+
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value) + `"`
+}
+
+// Generic is a module without a typed model
+type Generic struct {
+	ModuleName    string
+	ModuleOptions []Option
+}
+
+// Name returns the module name
+func (g *Generic) Name() string {
+	return g.ModuleName
+}
+
+// Options returns the module options
+func (g *Generic) Options() []Option {
+	return g.ModuleOptions
+}
@@ -0,0 +1,315 @@
+package sout
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChain_String(t *testing.T) {
+	t.Parallel()
+
+	testTable := []struct {
+		name     string
+		chain    Chain
+		expected string
+	}{
+		{
+			"transcode to HTTP",
+			Chain{
+				&Transcode{
+					VideoCodec:   "h264",
+					VideoBitrate: 800,
+					AudioCodec:   "mp4a",
+				},
+				&Std{
+					Access: "http",
+					Mux:    "ts",
+					Dst:    ":8081/x",
+				},
+			},
+			"#transcode{vcodec=h264,vb=800,acodec=mp4a}:std{access=http,mux=ts,dst=:8081/x}",
+		},
+		{
+			"duplicate with nested chains",
+			Chain{
+				&Duplicate{
+					Destinations: []Destination{
+						{
+							Chain: Chain{&Display{NoAudio: true}},
+						},
+						{
+							Select: "video",
+							Chain: Chain{
+								&Transcode{VideoCodec: "theo", Scale: 0.5},
+								&Std{Access: "file", Mux: "ogg", Dst: "/tmp/out.ogg"},
+							},
+						},
+					},
+				},
+			},
+			"#duplicate{dst=display{noaudio},dst=transcode{vcodec=theo,scale=0.5}:" +
+				"std{access=file,mux=ogg,dst=/tmp/out.ogg},select=video}",
+		},
+		{
+			"HLS",
+			Chain{
+				&Std{
+					Access: (&LiveHTTP{
+						SegmentLength: 10,
+						DeleteSegs:    true,
+						Index:         "/srv/stream.m3u8",
+						IndexURL:      "stream-########.ts",
+					}).String(),
+					Mux: "ts",
+					Dst: "/srv/stream-########.ts",
+				},
+			},
+			"#std{access=livehttp{seglen=10,delsegs,index=/srv/stream.m3u8,index-url=stream-########.ts}," +
+				"mux=ts,dst=/srv/stream-########.ts}",
+		},
+		{
+			"RTP with SDP",
+			Chain{
+				&RTP{
+					SDP:  "rtsp://:8554/stream",
+					Mux:  "ts",
+					Port: 5004,
+				},
+			},
+			"#rtp{port=5004,sdp=rtsp://:8554/stream,mux=ts}",
+		},
+		{
+			"ES",
+			Chain{
+				&ES{
+					AccessAudio: "file",
+					MuxAudio:    "raw",
+					DstAudio:    "/tmp/audio.mp3",
+				},
+			},
+			"#es{access-audio=file,mux-audio=raw,dst-audio=/tmp/audio.mp3}",
+		},
+		{
+			"quoted values and generic modules",
+			Chain{
+				&Std{
+					Access: "file",
+					Mux:    "mp4",
+					Dst:    `/tmp/my "video", final.mp4`,
+				},
+				&Generic{ModuleName: "gather"},
+			},
+			`#std{access=file,mux=mp4,dst="/tmp/my \"video\", final.mp4"}:gather`,
+		},
+	}
+
+	for _, testCase := range testTable {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			rendered := testCase.chain.String()
+
+			assert.Equal(t, testCase.expected, rendered)
+
+			// Make sure the chain can be parsed back
+			parsed, err := Parse(rendered)
+			require.NoError(t, err)
+
+			assert.Equal(t, testCase.chain, parsed)
+		})
+	}
+}
+
+func TestParse_WizardChains(t *testing.T) {
+	t.Parallel()
+
+	// Chains generated by the Qt streaming wizard
+	testTable := []struct {
+		name  string
+		chain string
+	}{
+		{
+			"HTTP with transcoding",
+			"#transcode{vcodec=h264,scale=Auto,acodec=mpga,ab=128,channels=2,samplerate=44100,scodec=none}:" +
+				"http{mux=ffmpeg{mux=flv},dst=:8080/}",
+		},
+		{
+			"RTP with transcoding",
+			"#transcode{vcodec=h264,vb=800k,scale=Auto,acodec=mp4a,ab=128,channels=2,samplerate=44100,scodec=none}:" +
+				"rtp{sdp=rtsp://:8554/stream}",
+		},
+		{
+			"file and display",
+			"#transcode{vcodec=VP80,vb=2000,acodec=vorb,ab=128,channels=2,samplerate=44100,scodec=none}:" +
+				"duplicate{dst=display,dst=std{access=file{no-overwrite},mux=webm,dst='/tmp/out.webm'}}",
+		},
+		{
+			"HLS",
+			"#transcode{vcodec=h264,scale=Auto,acodec=mp4a,ab=128,channels=2,samplerate=44100,scodec=none}:" +
+				"std{access=livehttp{seglen=10,delsegs=true,numsegs=5,index=/srv/stream.m3u8," +
+				"index-url=http://example.com/stream-########.ts},mux=ts{use-key-frames},dst=/srv/stream-########.ts}",
+		},
+	}
+
+	for _, testCase := range testTable {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			chain, err := Parse(testCase.chain)
+			require.NoError(t, err)
+
+			// Make sure the rendered chain parses back to the same model
+			reparsed, err := Parse(chain.String())
+			require.NoError(t, err)
+
+			assert.Equal(t, chain, reparsed)
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	t.Run("options are mapped to typed fields", func(t *testing.T) {
+		t.Parallel()
+
+		chain, err := Parse(
+			"#transcode{vcodec=h264,venc=x264{profile=baseline,preset=fast},deinterlace," +
+				"acodec=mp4a,ab=128,channels=2,samplerate=44100,custom=1}:" +
+				"std{access=http{mime=video/mp2t},mux=ts,dst=:8081/x}",
+		)
+		require.NoError(t, err)
+
+		require.Len(t, chain, 2)
+
+		transcode, ok := chain[0].(*Transcode)
+		require.True(t, ok)
+
+		assert.Equal(
+			t,
+			&Transcode{
+				VideoCodec:   "h264",
+				VideoEncoder: "x264{profile=baseline,preset=fast}",
+				Deinterlace:  true,
+				AudioCodec:   "mp4a",
+				AudioBitrate: 128,
+				Channels:     2,
+				SampleRate:   44100,
+				Extra:        []Option{{Key: "custom", Value: "1", HasValue: true}},
+			},
+			transcode,
+		)
+
+		std, ok := chain[1].(*Std)
+		require.True(t, ok)
+
+		assert.Equal(t, "http{mime=video/mp2t}", std.Access)
+		assert.Equal(t, ":8081/x", std.Dst)
+	})
+
+	t.Run("single quoted values", func(t *testing.T) {
+		t.Parallel()
+
+		chain, err := Parse(`std{dst='/tmp/a,b.ts'}`)
+		require.NoError(t, err)
+
+		assert.Equal(t, Chain{&Std{Dst: "/tmp/a,b.ts"}}, chain)
+	})
+
+	t.Run("single module", func(t *testing.T) {
+		t.Parallel()
+
+		module, err := ParseModule("livehttp{seglen=5,numsegs=3}")
+		require.NoError(t, err)
+
+		assert.Equal(t, &LiveHTTP{SegmentLength: 5, NumSegments: 3}, module)
+	})
+
+	t.Run("invalid chains", func(t *testing.T) {
+		t.Parallel()
+
+		testTable := []struct {
+			chain       string
+			expectedErr error
+		}{
+			{"#", errEmptyChain},
+			{"#std{access=http", errUnbalancedBraces},
+			{`#std{dst="/tmp/a.ts}`, errUnbalancedQuotes},
+			{"#std{mux=ts}x", errTrailingData},
+			{"#:std", errEmptyModule},
+		}
+
+		for _, testCase := range testTable {
+			_, err := Parse(testCase.chain)
+
+			assert.ErrorIs(t, err, testCase.expectedErr, testCase.chain)
+		}
+	})
+
+	t.Run("invalid duplicate options", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := Parse("#duplicate{select=video}")
+		assert.Error(t, err)
+
+		_, err = Parse("#duplicate{dst=display,unknown}")
+		assert.Error(t, err)
+	})
+
+	t.Run("untyped values are kept", func(t *testing.T) {
+		t.Parallel()
+
+		chain, err := Parse("#transcode{vb=800k,scale=Auto,deinterlace=maybe}:rtp{port=auto}")
+		require.NoError(t, err)
+
+		assert.Equal(
+			t,
+			Chain{
+				&Transcode{
+					Extra: []Option{
+						{Key: "vb", Value: "800k", HasValue: true},
+						{Key: "scale", Value: "Auto", HasValue: true},
+						{Key: "deinterlace", Value: "maybe", HasValue: true},
+					},
+				},
+				&RTP{
+					Extra: []Option{{Key: "port", Value: "auto", HasValue: true}},
+				},
+			},
+			chain,
+		)
+
+		assert.Equal(t, "#transcode{vb=800k,scale=Auto,deinterlace=maybe}:rtp{port=auto}", chain.String())
+	})
+
+	t.Run("explicit empty values", func(t *testing.T) {
+		t.Parallel()
+
+		chain, err := Parse(`#std{access=file,mux=ts,dst=""}:display{noaudio,delay=''}`)
+		require.NoError(t, err)
+
+		assert.Equal(
+			t,
+			Chain{
+				&Std{
+					Access: "file",
+					Mux:    "ts",
+					Extra:  []Option{{Key: "dst", HasValue: true}},
+				},
+				&Display{
+					NoAudio: true,
+					Extra:   []Option{{Key: "delay", HasValue: true}},
+				},
+			},
+			chain,
+		)
+
+		assert.Equal(t, `#std{access=file,mux=ts,dst=""}:display{noaudio,delay=""}`, chain.String())
+	})
+}
@@ -1,6 +1,7 @@
 package vlc
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"regexp"
@@ -29,11 +30,18 @@ const (
 	playNoVideo = "novideo"
 )
 
+// Playback states, as reported in Status.State
+const (
+	StatePlaying = "playing"
+	StatePaused  = "paused"
+	StateStopped = "stopped"
+)
+
 // executeStatusRequest executes a GET request and parses the response JSON
-func (v *VLC) executeStatusRequest(params paramMap) (*Status, error) {
+func (v *VLC) executeStatusRequest(ctx context.Context, params queryParams) (*Status, error) {
 	endpoint := buildQueryEndpoint(baseStatus, params)
 
-	statusRaw, err := v.client.Get(endpoint)
+	statusRaw, err := client.GetWithContext(ctx, v.client, endpoint)
 	if err != nil {
 		return nil, fmt.Errorf("unable to execute request, %s, %w", endpoint, err)
 	}
@@ -44,23 +52,40 @@ func (v *VLC) executeStatusRequest(params paramMap) (*Status, error) {
 // GetStatus returns the latest status information,
 // including current item info and metadata
 func (v *VLC) GetStatus() (*Status, error) {
-	return v.executeStatusRequest(nil)
+	return v.GetStatusContext(context.Background())
+}
+
+// GetStatusContext is GetStatus with a context that controls the request lifetime
+func (v *VLC) GetStatusContext(ctx context.Context) (*Status, error) {
+	return v.executeStatusRequest(ctx, nil)
 }
 
 // EmptyPlaylist empties the current playlist
 func (v *VLC) EmptyPlaylist() (*Status, error) {
+	return v.EmptyPlaylistContext(context.Background())
+}
+
+// EmptyPlaylistContext is EmptyPlaylist with a context that controls the request lifetime
+func (v *VLC) EmptyPlaylistContext(ctx context.Context) (*Status, error) {
 	params := paramMap{
 		commandKey: emptyCommand,
 	}
 
-	return v.executeStatusRequest(params)
+	return v.executeStatusRequest(ctx, params)
 }
 
 // PlaySource adds the given source (URI) to the playlist and starts playing with the given option.
 // Options can have the value of:
 //   - noaudio
 //   - novideo
+//
+// For any other input options, use PlaySourceWithOptions
 func (v *VLC) PlaySource(source string, option ...string) (*Status, error) {
+	return v.PlaySourceContext(context.Background(), source, option...)
+}
+
+// PlaySourceContext is PlaySource with a context that controls the request lifetime
+func (v *VLC) PlaySourceContext(ctx context.Context, source string, option ...string) (*Status, error) {
 	params := paramMap{
 		commandKey: inPlayCommand,
 		inputKey:   source,
@@ -77,112 +102,168 @@ func (v *VLC) PlaySource(source string, option ...string) (*Status, error) {
 		params[optionKey] = option[0]
 	}
 
-	return v.executeStatusRequest(params)
+	return v.executeStatusRequest(ctx, params)
 }
 
-// AddToPlaylist adds a source (URI) to the playlist
+// AddToPlaylist adds a source (URI) to the playlist.
+// For input options, use AddToPlaylistWithOptions
 func (v *VLC) AddToPlaylist(source string) (*Status, error) {
+	return v.AddToPlaylistContext(context.Background(), source)
+}
+
+// AddToPlaylistContext is AddToPlaylist with a context that controls the request lifetime
+func (v *VLC) AddToPlaylistContext(ctx context.Context, source string) (*Status, error) {
 	params := paramMap{
 		commandKey: inEnqueueCommand,
 		inputKey:   source,
 	}
 
-	return v.executeStatusRequest(params)
+	return v.executeStatusRequest(ctx, params)
 }
 
 // PlayLastActivePlaylistItem plays the last active item
 func (v *VLC) PlayLastActivePlaylistItem() (*Status, error) {
+	return v.PlayLastActivePlaylistItemContext(context.Background())
+}
+
+// PlayLastActivePlaylistItemContext is PlayLastActivePlaylistItem with a context that controls the request lifetime
+func (v *VLC) PlayLastActivePlaylistItemContext(ctx context.Context) (*Status, error) {
 	params := paramMap{
 		commandKey: playCommand,
 	}
 
-	return v.executeStatusRequest(params)
+	return v.executeStatusRequest(ctx, params)
 }
 
 // PlayPlaylistItem plays the playlist item with the given ID
 func (v *VLC) PlayPlaylistItem(id int) (*Status, error) {
+	return v.PlayPlaylistItemContext(context.Background(), id)
+}
+
+// PlayPlaylistItemContext is PlayPlaylistItem with a context that controls the request lifetime
+func (v *VLC) PlayPlaylistItemContext(ctx context.Context, id int) (*Status, error) {
 	params := paramMap{
 		commandKey: playCommand,
 		idKey:      strconv.Itoa(id),
 	}
 
-	return v.executeStatusRequest(params)
+	return v.executeStatusRequest(ctx, params)
 }
 
 // PauseWithLastActivePlaylistItem pauses playback. If the current state was 'stop',
 // it plays the current item. If there is no current item, it plays the first item in the playlist
 func (v *VLC) PauseWithLastActivePlaylistItem() (*Status, error) {
+	return v.PauseWithLastActivePlaylistItemContext(context.Background())
+}
+
+// PauseWithLastActivePlaylistItemContext is PauseWithLastActivePlaylistItem with a context that controls the request lifetime
+func (v *VLC) PauseWithLastActivePlaylistItemContext(ctx context.Context) (*Status, error) {
 	params := paramMap{
 		commandKey: pauseCommand,
 	}
 
-	return v.executeStatusRequest(params)
+	return v.executeStatusRequest(ctx, params)
 }
 
 // PausePlaylist pauses playback. If the current state was 'stop',
 // it plays the item with the given ID
 func (v *VLC) PausePlaylist(id int) (*Status, error) {
+	return v.PausePlaylistContext(context.Background(), id)
+}
+
+// PausePlaylistContext is PausePlaylist with a context that controls the request lifetime
+func (v *VLC) PausePlaylistContext(ctx context.Context, id int) (*Status, error) {
 	params := paramMap{
 		commandKey: pauseCommand,
 		idKey:      strconv.Itoa(id),
 	}
 
-	return v.executeStatusRequest(params)
+	return v.executeStatusRequest(ctx, params)
 }
 
 // ForceResumePlaylist resumes playback if paused, otherwise does nothing
 func (v *VLC) ForceResumePlaylist() (*Status, error) {
+	return v.ForceResumePlaylistContext(context.Background())
+}
+
+// ForceResumePlaylistContext is ForceResumePlaylist with a context that controls the request lifetime
+func (v *VLC) ForceResumePlaylistContext(ctx context.Context) (*Status, error) {
 	params := paramMap{
 		commandKey: forceResumeCommand,
 	}
 
-	return v.executeStatusRequest(params)
+	return v.executeStatusRequest(ctx, params)
 }
 
 // ForcePausePlaylist pauses playback if not paused, otherwise does nothing
 func (v *VLC) ForcePausePlaylist() (*Status, error) {
+	return v.ForcePausePlaylistContext(context.Background())
+}
+
+// ForcePausePlaylistContext is ForcePausePlaylist with a context that controls the request lifetime
+func (v *VLC) ForcePausePlaylistContext(ctx context.Context) (*Status, error) {
 	params := paramMap{
 		commandKey: forcePauseCommand,
 	}
 
-	return v.executeStatusRequest(params)
+	return v.executeStatusRequest(ctx, params)
 }
 
 // StopPlaylist stops the playback
 func (v *VLC) StopPlaylist() (*Status, error) {
+	return v.StopPlaylistContext(context.Background())
+}
+
+// StopPlaylistContext is StopPlaylist with a context that controls the request lifetime
+func (v *VLC) StopPlaylistContext(ctx context.Context) (*Status, error) {
 	params := paramMap{
 		commandKey: stopCommand,
 	}
 
-	return v.executeStatusRequest(params)
+	return v.executeStatusRequest(ctx, params)
 }
 
 // PlayNextInPlaylist plays the next item in the playlist
 func (v *VLC) PlayNextInPlaylist() (*Status, error) {
+	return v.PlayNextInPlaylistContext(context.Background())
+}
+
+// PlayNextInPlaylistContext is PlayNextInPlaylist with a context that controls the request lifetime
+func (v *VLC) PlayNextInPlaylistContext(ctx context.Context) (*Status, error) {
 	params := paramMap{
 		commandKey: nextCommand,
 	}
 
-	return v.executeStatusRequest(params)
+	return v.executeStatusRequest(ctx, params)
 }
 
 // PlayPreviousInPlaylist plays the previous item in the playlist
 func (v *VLC) PlayPreviousInPlaylist() (*Status, error) {
+	return v.PlayPreviousInPlaylistContext(context.Background())
+}
+
+// PlayPreviousInPlaylistContext is PlayPreviousInPlaylist with a context that controls the request lifetime
+func (v *VLC) PlayPreviousInPlaylistContext(ctx context.Context) (*Status, error) {
 	params := paramMap{
 		commandKey: previousCommand,
 	}
 
-	return v.executeStatusRequest(params)
+	return v.executeStatusRequest(ctx, params)
 }
 
 // DeleteFromPlaylist deletes the item with the given ID from the playlist
 func (v *VLC) DeleteFromPlaylist(id int) (*Status, error) {
+	return v.DeleteFromPlaylistContext(context.Background(), id)
+}
+
+// DeleteFromPlaylistContext is DeleteFromPlaylist with a context that controls the request lifetime
+func (v *VLC) DeleteFromPlaylistContext(ctx context.Context, id int) (*Status, error) {
 	params := paramMap{
 		commandKey: deleteCommand,
 		idKey:      strconv.Itoa(id),
 	}
 
-	return v.executeStatusRequest(params)
+	return v.executeStatusRequest(ctx, params)
 }
 
 // SortPlaylist sorts the playlist using the given sort mode and order.
@@ -196,6 +277,11 @@ func (v *VLC) DeleteFromPlaylist(id int) (*Status, error) {
 //   - 5 Random
 //   - 7 Track number
 func (v *VLC) SortPlaylist(id, mode int) (*Status, error) {
+	return v.SortPlaylistContext(context.Background(), id, mode)
+}
+
+// SortPlaylistContext is SortPlaylist with a context that controls the request lifetime
+func (v *VLC) SortPlaylistContext(ctx context.Context, id, mode int) (*Status, error) {
 	// Make sure the sort ID is valid
 	if id != 0 && id != 1 {
 		return nil, errInvalidSortMode
@@ -207,34 +293,49 @@ func (v *VLC) SortPlaylist(id, mode int) (*Status, error) {
 		valKey:     strconv.Itoa(mode),
 	}
 
-	return v.executeStatusRequest(params)
+	return v.executeStatusRequest(ctx, params)
 }
 
 // TogglePlaylistRandom toggles random playlist playback
 func (v *VLC) TogglePlaylistRandom() (*Status, error) {
+	return v.TogglePlaylistRandomContext(context.Background())
+}
+
+// TogglePlaylistRandomContext is TogglePlaylistRandom with a context that controls the request lifetime
+func (v *VLC) TogglePlaylistRandomContext(ctx context.Context) (*Status, error) {
 	params := paramMap{
 		commandKey: randomCommand,
 	}
 
-	return v.executeStatusRequest(params)
+	return v.executeStatusRequest(ctx, params)
 }
 
 // TogglePlaylistLoop toggles a playlist playback loop
 func (v *VLC) TogglePlaylistLoop() (*Status, error) {
+	return v.TogglePlaylistLoopContext(context.Background())
+}
+
+// TogglePlaylistLoopContext is TogglePlaylistLoop with a context that controls the request lifetime
+func (v *VLC) TogglePlaylistLoopContext(ctx context.Context) (*Status, error) {
 	params := paramMap{
 		commandKey: loopCommand,
 	}
 
-	return v.executeStatusRequest(params)
+	return v.executeStatusRequest(ctx, params)
 }
 
 // TogglePlaylistRepeat toggles a playlist playback repeat
 func (v *VLC) TogglePlaylistRepeat() (*Status, error) {
+	return v.TogglePlaylistRepeatContext(context.Background())
+}
+
+// TogglePlaylistRepeatContext is TogglePlaylistRepeat with a context that controls the request lifetime
+func (v *VLC) TogglePlaylistRepeatContext(ctx context.Context) (*Status, error) {
 	params := paramMap{
 		commandKey: repeatCommand,
 	}
 
-	return v.executeStatusRequest(params)
+	return v.executeStatusRequest(ctx, params)
 }
 
 // EnableServiceDiscoveryModule enables the given service discovery module.
@@ -244,21 +345,31 @@ func (v *VLC) TogglePlaylistRepeat() (*Status, error) {
 //   - podcast
 //   - hal
 func (v *VLC) EnableServiceDiscoveryModule(module string) (*Status, error) {
+	return v.EnableServiceDiscoveryModuleContext(context.Background(), module)
+}
+
+// EnableServiceDiscoveryModuleContext is EnableServiceDiscoveryModule with a context that controls the request lifetime
+func (v *VLC) EnableServiceDiscoveryModuleContext(ctx context.Context, module string) (*Status, error) {
 	params := paramMap{
 		commandKey: serviceDiscoveryCommand,
 		valKey:     module,
 	}
 
-	return v.executeStatusRequest(params)
+	return v.executeStatusRequest(ctx, params)
 }
 
 // ToggleFullscreen toggles fullscreen playback
 func (v *VLC) ToggleFullscreen() (*Status, error) {
+	return v.ToggleFullscreenContext(context.Background())
+}
+
+// ToggleFullscreenContext is ToggleFullscreen with a context that controls the request lifetime
+func (v *VLC) ToggleFullscreenContext(ctx context.Context) (*Status, error) {
 	params := paramMap{
 		commandKey: fullscreenCommand,
 	}
 
-	return v.executeStatusRequest(params)
+	return v.executeStatusRequest(ctx, params)
 }
 
 // SetVolume sets the playback volume.
@@ -266,6 +377,11 @@ func (v *VLC) ToggleFullscreen() (*Status, error) {
 // Allowed values are of the form:
 // +<int>, -<int>, <int> or <int>%
 func (v *VLC) SetVolume(volume string) (*Status, error) {
+	return v.SetVolumeContext(context.Background(), volume)
+}
+
+// SetVolumeContext is SetVolume with a context that controls the request lifetime
+func (v *VLC) SetVolumeContext(ctx context.Context, volume string) (*Status, error) {
 	// Make sure the volume value is valid
 	if !volumeRegex.MatchString(volume) {
 		return nil, errInvalidVolumeValue
@@ -276,7 +392,7 @@ func (v *VLC) SetVolume(volume string) (*Status, error) {
 		valKey:     volume,
 	}
 
-	return v.executeStatusRequest(params)
+	return v.executeStatusRequest(ctx, params)
 }
 
 // SeekToValue seeks the playback to the given value.
@@ -294,6 +410,11 @@ func (v *VLC) SetVolume(volume string) (*Status, error) {
 // +1H:2M -> seek 1 hour and 2 minutes forward
 // -10% -> seek 10% back
 func (v *VLC) SeekToValue(value string) (*Status, error) {
+	return v.SeekToValueContext(context.Background(), value)
+}
+
+// SeekToValueContext is SeekToValue with a context that controls the request lifetime
+func (v *VLC) SeekToValueContext(ctx context.Context, value string) (*Status, error) {
 	// Make sure the seek value is valid
 	if !seekNumberRegex.MatchString(value) &&
 		!seekFormatRegex.MatchString(value) {
@@ -305,23 +426,33 @@ func (v *VLC) SeekToValue(value string) (*Status, error) {
 		valKey:     value,
 	}
 
-	return v.executeStatusRequest(params)
+	return v.executeStatusRequest(ctx, params)
 }
 
 // AddSubtitle adds the given subtitle to the currently playing file
 func (v *VLC) AddSubtitle(subtitleURI string) (*Status, error) {
+	return v.AddSubtitleContext(context.Background(), subtitleURI)
+}
+
+// AddSubtitleContext is AddSubtitle with a context that controls the request lifetime
+func (v *VLC) AddSubtitleContext(ctx context.Context, subtitleURI string) (*Status, error) {
 	params := paramMap{
 		commandKey: addSubtitleCommand,
 		valKey:     subtitleURI,
 	}
 
-	return v.executeStatusRequest(params)
+	return v.executeStatusRequest(ctx, params)
 }
 
 // SetPreamp sets the preamp value.
 //
 // Must be >=-20 and <=20
 func (v *VLC) SetPreamp(gain int) (*Status, error) {
+	return v.SetPreampContext(context.Background(), gain)
+}
+
+// SetPreampContext is SetPreamp with a context that controls the request lifetime
+func (v *VLC) SetPreampContext(ctx context.Context, gain int) (*Status, error) {
 	// Make sure the gain value is valid
 	if gain < -20 || gain > 20 {
 		return nil, errInvalidPreampGainValue
@@ -332,13 +463,18 @@ func (v *VLC) SetPreamp(gain int) (*Status, error) {
 		valKey:     strconv.Itoa(gain),
 	}
 
-	return v.executeStatusRequest(params)
+	return v.executeStatusRequest(ctx, params)
 }
 
 // SetEQ sets the gain for a specific band.
 //
 // Gain must be in Db and >=-20 and <=20
 func (v *VLC) SetEQ(band, gain int) (*Status, error) {
+	return v.SetEQContext(context.Background(), band, gain)
+}
+
+// SetEQContext is SetEQ with a context that controls the request lifetime
+func (v *VLC) SetEQContext(ctx context.Context, band, gain int) (*Status, error) {
 	// Make sure the gain value is valid
 	if gain < -20 || gain > 20 {
 		return nil, errInvalidPreampGainValue
@@ -350,11 +486,16 @@ func (v *VLC) SetEQ(band, gain int) (*Status, error) {
 		valKey:     strconv.Itoa(gain),
 	}
 
-	return v.executeStatusRequest(params)
+	return v.executeStatusRequest(ctx, params)
 }
 
 // EnableEQ enables or disables the equalizer
 func (v *VLC) EnableEQ(value bool) (*Status, error) {
+	return v.EnableEQContext(context.Background(), value)
+}
+
+// EnableEQContext is EnableEQ with a context that controls the request lifetime
+func (v *VLC) EnableEQContext(ctx context.Context, value bool) (*Status, error) {
 	enableValue := "0"
 
 	if value {
@@ -366,7 +507,7 @@ func (v *VLC) EnableEQ(value bool) (*Status, error) {
 		valKey:     enableValue,
 	}
 
-	return v.executeStatusRequest(params)
+	return v.executeStatusRequest(ctx, params)
 }
 
 // SetEQPreset sets the equalizer preset as per the ID specified.
@@ -375,91 +516,136 @@ func (v *VLC) EnableEQ(value bool) (*Status, error) {
 // Band 0: 60 Hz, 1: 170 Hz, 2: 310 Hz, 3: 600 Hz, 4: 1 kHz,
 // 5: 3 kHz, 6: 6 kHz, 7: 12 kHz , 8: 14 kHz , 9: 16 kHz
 func (v *VLC) SetEQPreset(id int) (*Status, error) {
+	return v.SetEQPresetContext(context.Background(), id)
+}
+
+// SetEQPresetContext is SetEQPreset with a context that controls the request lifetime
+func (v *VLC) SetEQPresetContext(ctx context.Context, id int) (*Status, error) {
 	params := paramMap{
 		commandKey: setpresetCommand,
 		idKey:      strconv.Itoa(id),
 	}
 
-	return v.executeStatusRequest(params)
+	return v.executeStatusRequest(ctx, params)
 }
 
 // SelectTitle selects the title with the given title ID
 func (v *VLC) SelectTitle(id int) (*Status, error) {
+	return v.SelectTitleContext(context.Background(), id)
+}
+
+// SelectTitleContext is SelectTitle with a context that controls the request lifetime
+func (v *VLC) SelectTitleContext(ctx context.Context, id int) (*Status, error) {
 	params := paramMap{
 		commandKey: titleCommand,
 		valKey:     strconv.Itoa(id),
 	}
 
-	return v.executeStatusRequest(params)
+	return v.executeStatusRequest(ctx, params)
 }
 
 // SelectChapter selects the chapter with the given chapter ID
 func (v *VLC) SelectChapter(id int) (*Status, error) {
+	return v.SelectChapterContext(context.Background(), id)
+}
+
+// SelectChapterContext is SelectChapter with a context that controls the request lifetime
+func (v *VLC) SelectChapterContext(ctx context.Context, id int) (*Status, error) {
 	params := paramMap{
 		commandKey: chapterCommand,
 		valKey:     strconv.Itoa(id),
 	}
 
-	return v.executeStatusRequest(params)
+	return v.executeStatusRequest(ctx, params)
 }
 
 // SelectAudioTrack selects the audio track with the given audio track ID
 // (use the number from the stream)
 func (v *VLC) SelectAudioTrack(id int) (*Status, error) {
+	return v.SelectAudioTrackContext(context.Background(), id)
+}
+
+// SelectAudioTrackContext is SelectAudioTrack with a context that controls the request lifetime
+func (v *VLC) SelectAudioTrackContext(ctx context.Context, id int) (*Status, error) {
 	params := paramMap{
 		commandKey: audioTrackCommand,
 		valKey:     strconv.Itoa(id),
 	}
 
-	return v.executeStatusRequest(params)
+	return v.executeStatusRequest(ctx, params)
 }
 
 // SelectVideoTrack selects the video track with the given video track ID
 // (use the number from the stream)
 func (v *VLC) SelectVideoTrack(id int) (*Status, error) {
+	return v.SelectVideoTrackContext(context.Background(), id)
+}
+
+// SelectVideoTrackContext is SelectVideoTrack with a context that controls the request lifetime
+func (v *VLC) SelectVideoTrackContext(ctx context.Context, id int) (*Status, error) {
 	params := paramMap{
 		commandKey: videoTrackCommand,
 		valKey:     strconv.Itoa(id),
 	}
 
-	return v.executeStatusRequest(params)
+	return v.executeStatusRequest(ctx, params)
 }
 
 // SelectSubtitleTrack selects the subtitle track with the given subtitle track ID
 // (use the number from the stream)
 func (v *VLC) SelectSubtitleTrack(id int) (*Status, error) {
+	return v.SelectSubtitleTrackContext(context.Background(), id)
+}
+
+// SelectSubtitleTrackContext is SelectSubtitleTrack with a context that controls the request lifetime
+func (v *VLC) SelectSubtitleTrackContext(ctx context.Context, id int) (*Status, error) {
 	params := paramMap{
 		commandKey: subtitleTrackCommand,
 		valKey:     strconv.Itoa(id),
 	}
 
-	return v.executeStatusRequest(params)
+	return v.executeStatusRequest(ctx, params)
 }
 
 // SetAudioDelay sets the audio delay in seconds
 func (v *VLC) SetAudioDelay(delay float64) (*Status, error) {
+	return v.SetAudioDelayContext(context.Background(), delay)
+}
+
+// SetAudioDelayContext is SetAudioDelay with a context that controls the request lifetime
+func (v *VLC) SetAudioDelayContext(ctx context.Context, delay float64) (*Status, error) {
 	params := paramMap{
 		commandKey: audioDelayCommand,
 		valKey:     fmt.Sprintf("%f", delay),
 	}
 
-	return v.executeStatusRequest(params)
+	return v.executeStatusRequest(ctx, params)
 }
 
 // SetSubtitleDelay sets the subtitle delay in seconds
 func (v *VLC) SetSubtitleDelay(delay float64) (*Status, error) {
+	return v.SetSubtitleDelayContext(context.Background(), delay)
+}
+
+// SetSubtitleDelayContext is SetSubtitleDelay with a context that controls the request lifetime
+func (v *VLC) SetSubtitleDelayContext(ctx context.Context, delay float64) (*Status, error) {
 	params := paramMap{
 		commandKey: subtitleDelayCommand,
 		valKey:     fmt.Sprintf("%f", delay),
 	}
 
-	return v.executeStatusRequest(params)
+	return v.executeStatusRequest(ctx, params)
 }
 
 // SetPlaybackRate sets the playback rate.
 //
 // Must be > 0
 func (v *VLC) SetPlaybackRate(rate float64) (*Status, error) {
+	return v.SetPlaybackRateContext(context.Background(), rate)
+}
+
+// SetPlaybackRateContext is SetPlaybackRate with a context that controls the request lifetime
+func (v *VLC) SetPlaybackRateContext(ctx context.Context, rate float64) (*Status, error) {
 	// Make sure the playback rate is valid
 	if rate <= 0 {
 		return nil, errInvalidPlaybackRate
@@ -470,7 +656,7 @@ func (v *VLC) SetPlaybackRate(rate float64) (*Status, error) {
 		valKey:     fmt.Sprintf("%f", rate),
 	}
 
-	return v.executeStatusRequest(params)
+	return v.executeStatusRequest(ctx, params)
 }
 
 // SetAspectRatio sets the aspect ratio.
@@ -485,10 +671,15 @@ func (v *VLC) SetPlaybackRate(rate float64) (*Status, error) {
 //   - 235:100
 //   - 239:100
 func (v *VLC) SetAspectRatio(ratio string) (*Status, error) {
+	return v.SetAspectRatioContext(context.Background(), ratio)
+}
+
+// SetAspectRatioContext is SetAspectRatio with a context that controls the request lifetime
+func (v *VLC) SetAspectRatioContext(ctx context.Context, ratio string) (*Status, error) {
 	params := paramMap{
 		commandKey: aspectRatioCommand,
 		valKey:     ratio,
 	}
 
-	return v.executeStatusRequest(params)
+	return v.executeStatusRequest(ctx, params)
 }
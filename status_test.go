@@ -1,6 +1,7 @@
 package vlc
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/zivkovicmilos/go-vlc/client"
 )
 
 func TestVLC_GetStatus(t *testing.T) {
@@ -36,6 +38,28 @@ func TestVLC_GetStatus(t *testing.T) {
 		assert.ErrorIs(t, err, fetchErr)
 	})
 
+	t.Run("invalid status response", func(t *testing.T) {
+		t.Parallel()
+
+		mockClient := &mockClient{
+			getFn: func(endpoint string) ([]byte, error) {
+				require.Equal(t, baseStatus, endpoint)
+
+				return []byte("invalid"), nil
+			},
+		}
+
+		vlc := NewVLC(mockClient)
+
+		status, err := vlc.GetStatus()
+
+		assert.Nil(t, status)
+
+		var decodeErr *client.DecodeError
+
+		assert.ErrorAs(t, err, &decodeErr)
+	})
+
 	t.Run("valid status fetched", func(t *testing.T) {
 		t.Parallel()
 
@@ -63,6 +87,86 @@ func TestVLC_GetStatus(t *testing.T) {
 	})
 }
 
+func TestVLC_GetStatusContext(t *testing.T) {
+	t.Parallel()
+
+	t.Run("context is passed to the client", func(t *testing.T) {
+		t.Parallel()
+
+		type ctxKey struct{}
+
+		var (
+			ctx = context.WithValue(context.Background(), ctxKey{}, "value")
+
+			expectedStatus = &Status{
+				Version: "random version",
+				State:   "playing",
+			}
+
+			mockClient = &mockClient{
+				getContextFn: func(reqCtx context.Context, endpoint string) ([]byte, error) {
+					require.Equal(t, baseStatus, endpoint)
+					require.Equal(t, "value", reqCtx.Value(ctxKey{}))
+
+					return json.Marshal(expectedStatus)
+				},
+			}
+		)
+
+		vlc := NewVLC(mockClient)
+
+		status, err := vlc.GetStatusContext(ctx)
+		require.NoError(t, err)
+
+		assert.Equal(t, expectedStatus, status)
+	})
+
+	t.Run("context cancellation is propagated", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		mockClient := &mockClient{
+			getContextFn: func(reqCtx context.Context, _ string) ([]byte, error) {
+				return nil, reqCtx.Err()
+			},
+		}
+
+		vlc := NewVLC(mockClient)
+
+		status, err := vlc.GetStatusContext(ctx)
+
+		assert.Nil(t, status)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("client without context support", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			expectedStatus = &Status{
+				Version: "random version",
+				State:   "playing",
+			}
+
+			getClient = client.ClientFunc(func(_ context.Context, endpoint string) ([]byte, error) {
+				require.Equal(t, baseStatus, endpoint)
+
+				return json.Marshal(expectedStatus)
+			})
+		)
+
+		// Only expose Get, as external Client implementations do
+		vlc := NewVLC(struct{ client.Client }{getClient})
+
+		status, err := vlc.GetStatusContext(context.Background())
+		require.NoError(t, err)
+
+		assert.Equal(t, expectedStatus, status)
+	})
+}
+
 func TestVLC_EmptyPlaylist(t *testing.T) {
 	t.Parallel()
 
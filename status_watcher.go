@@ -0,0 +1,189 @@
+package vlc
+
+import (
+	"context"
+	"time"
+)
+
+// StatusEventType is the type of the status change
+type StatusEventType int
+
+const (
+	StateChanged      StatusEventType = iota // playing, paused, stopped
+	ItemChanged                              // CurrentPLID
+	VolumeChanged                            // Volume
+	RateChanged                              // Rate
+	Seeked                                   // Time jumped, for the same item
+	FullscreenChanged                        // FullScreen
+	RandomChanged                            // Random
+	LoopChanged                              // Loop
+	RepeatChanged                            // Repeat
+	TrackEnded                               // the previous item played until its end
+)
+
+// statusEventNames are the string representations of the status event types
+var statusEventNames = map[StatusEventType]string{
+	StateChanged:      "state changed",
+	ItemChanged:       "item changed",
+	VolumeChanged:     "volume changed",
+	RateChanged:       "rate changed",
+	Seeked:            "seeked",
+	FullscreenChanged: "fullscreen changed",
+	RandomChanged:     "random changed",
+	LoopChanged:       "loop changed",
+	RepeatChanged:     "repeat changed",
+	TrackEnded:        "track ended",
+}
+
+// String returns the string representation of the status event type
+func (t StatusEventType) String() string {
+	if name, ok := statusEventNames[t]; ok {
+		return name
+	}
+
+	return "unknown"
+}
+
+// StatusEvent is a single change between two consecutive statuses
+type StatusEvent struct {
+	Previous *Status
+	Current  *Status
+	Type     StatusEventType
+}
+
+// StatusWatcher polls the VLC status, and emits the changes between consecutive statuses
+type StatusWatcher struct {
+	emitter[StatusEvent]
+
+	vlc *VLC
+	cfg *watcherConfig
+}
+
+// NewStatusWatcher creates a new status watcher for the given VLC instance
+func NewStatusWatcher(vlc *VLC, opts ...WatcherOption) *StatusWatcher {
+	return &StatusWatcher{
+		vlc: vlc,
+		cfg: newWatcherConfig(opts),
+	}
+}
+
+// Run polls the status, and emits events until the context is done
+func (w *StatusWatcher) Run(ctx context.Context) {
+	sub := w.subscribe()
+	defer sub.close()
+
+	var (
+		previous     *Status
+		previousTime time.Time
+	)
+
+	w.cfg.poll(ctx, func(ctx context.Context, now time.Time) {
+		current, err := w.vlc.GetStatusContext(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				w.cfg.onError(err)
+			}
+
+			return
+		}
+
+		if previous != nil {
+			for _, event := range diffStatus(previous, current, now.Sub(previousTime), w.cfg.seekThreshold) {
+				if !sub.emit(ctx, event) {
+					return
+				}
+			}
+		}
+
+		previous, previousTime = current, now
+	})
+}
+
+// diffStatus returns the events between the previous and current status,
+// which were fetched the given time apart
+func diffStatus(previous, current *Status, elapsed, seekThreshold time.Duration) []StatusEvent {
+	var (
+		events = make([]StatusEvent, 0)
+
+		sameItem    = previous.CurrentPLID == current.CurrentPLID
+		wasPlaying  = previous.State == StatePlaying
+		isPlaying   = current.State == StatePlaying
+		progression = 0.0
+
+		threshold = seekThreshold.Seconds()
+	)
+
+	add := func(eventType StatusEventType) {
+		events = append(events, StatusEvent{
+			Type:     eventType,
+			Previous: previous,
+			Current:  current,
+		})
+	}
+
+	// The playback progressed for (up to) the elapsed time, if it was playing at any point
+	if wasPlaying || isPlaying {
+		progression = elapsed.Seconds() * previous.Rate
+	}
+
+	// The expected time range for the current status, if there was no seek.
+	// If the playback was playing throughout, the range is a single point
+	var (
+		minExpected = float64(previous.Time)
+		maxExpected = minExpected + progression
+	)
+
+	if wasPlaying && isPlaying {
+		minExpected = maxExpected
+	}
+
+	// The previous item ended, if it reached its end, and playback moved on
+	reachedEnd := wasPlaying &&
+		previous.Length > 0 &&
+		maxExpected+threshold >= float64(previous.Length)
+
+	if reachedEnd && (!sameItem || current.State == StateStopped) {
+		add(TrackEnded)
+	}
+
+	if !sameItem {
+		add(ItemChanged)
+	}
+
+	if previous.State != current.State {
+		add(StateChanged)
+	}
+
+	currentTime := float64(current.Time)
+
+	if sameItem && current.State != StateStopped &&
+		(currentTime < minExpected-threshold || currentTime > maxExpected+threshold) {
+		add(Seeked)
+	}
+
+	if previous.Volume != current.Volume {
+		add(VolumeChanged)
+	}
+
+	if previous.Rate != current.Rate {
+		add(RateChanged)
+	}
+
+	if previous.FullScreen != current.FullScreen {
+		add(FullscreenChanged)
+	}
+
+	if previous.Random != current.Random {
+		add(RandomChanged)
+	}
+
+	if previous.Loop != current.Loop {
+		add(LoopChanged)
+	}
+
+	if previous.Repeat != current.Repeat {
+		add(RepeatChanged)
+	}
+
+	return events
+}
@@ -0,0 +1,331 @@
+package vlc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// eventTypes extracts the event types, in order
+func eventTypes(events []StatusEvent) []StatusEventType {
+	types := make([]StatusEventType, 0, len(events))
+
+	for _, event := range events {
+		types = append(types, event.Type)
+	}
+
+	return types
+}
+
+func TestDiffStatus(t *testing.T) {
+	t.Parallel()
+
+	playing := Status{
+		State:       StatePlaying,
+		CurrentPLID: 4,
+		Time:        10,
+		Length:      100,
+		Rate:        1,
+		Volume:      256,
+	}
+
+	testTable := []struct {
+		name     string
+		previous Status
+		current  func(s Status) Status
+		elapsed  time.Duration
+		expected []StatusEventType
+	}{
+		{
+			"regular playback",
+			playing,
+			func(s Status) Status {
+				s.Time = 11
+
+				return s
+			},
+			time.Second,
+			[]StatusEventType{},
+		},
+		{
+			"paused",
+			playing,
+			func(s Status) Status {
+				s.State = StatePaused
+				s.Time = 11
+
+				return s
+			},
+			time.Second,
+			[]StatusEventType{StateChanged},
+		},
+		{
+			"resumed after a long pause",
+			func() Status {
+				s := playing
+				s.State = StatePaused
+
+				return s
+			}(),
+			func(s Status) Status {
+				s.State = StatePlaying
+				s.Time = 15
+
+				return s
+			},
+			10 * time.Second,
+			[]StatusEventType{StateChanged},
+		},
+		{
+			"seek forward",
+			playing,
+			func(s Status) Status {
+				s.Time = 60
+
+				return s
+			},
+			time.Second,
+			[]StatusEventType{Seeked},
+		},
+		{
+			"seek backward",
+			playing,
+			func(s Status) Status {
+				s.Time = 2
+
+				return s
+			},
+			time.Second,
+			[]StatusEventType{Seeked},
+		},
+		{
+			"item skipped",
+			playing,
+			func(s Status) Status {
+				s.CurrentPLID = 5
+				s.Time = 0
+
+				return s
+			},
+			time.Second,
+			[]StatusEventType{ItemChanged},
+		},
+		{
+			"track ended, and the next one started",
+			func() Status {
+				s := playing
+				s.Time = 99
+
+				return s
+			}(),
+			func(s Status) Status {
+				s.CurrentPLID = 5
+				s.Time = 0
+
+				return s
+			},
+			time.Second,
+			[]StatusEventType{TrackEnded, ItemChanged},
+		},
+		{
+			"track ended, and playback stopped",
+			func() Status {
+				s := playing
+				s.Time = 99
+
+				return s
+			}(),
+			func(s Status) Status {
+				s.State = StateStopped
+				s.CurrentPLID = -1
+				s.Time = 0
+
+				return s
+			},
+			time.Second,
+			[]StatusEventType{TrackEnded, ItemChanged, StateChanged},
+		},
+		{
+			"toggles",
+			playing,
+			func(s Status) Status {
+				s.Time = 11
+				s.Volume = 128
+				s.Rate = 1.5
+				s.FullScreen = 1
+				s.Random = true
+				s.Loop = true
+				s.Repeat = true
+
+				return s
+			},
+			time.Second,
+			[]StatusEventType{
+				VolumeChanged,
+				RateChanged,
+				FullscreenChanged,
+				RandomChanged,
+				LoopChanged,
+				RepeatChanged,
+			},
+		},
+	}
+
+	for _, testCase := range testTable {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				previous = testCase.previous
+				current  = testCase.current(previous)
+			)
+
+			events := diffStatus(&previous, &current, testCase.elapsed, defaultSeekThreshold)
+
+			assert.Equal(t, testCase.expected, eventTypes(events))
+
+			for _, event := range events {
+				assert.Equal(t, &previous, event.Previous)
+				assert.Equal(t, &current, event.Current)
+			}
+		})
+	}
+}
+
+func TestStatusWatcher_Run(t *testing.T) {
+	t.Parallel()
+
+	t.Run("events are emitted", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			calls    atomic.Int64
+			statuses = []*Status{
+				{State: StatePlaying, CurrentPLID: 1, Rate: 1, Volume: 256},
+				{State: StatePaused, CurrentPLID: 1, Rate: 1, Volume: 256},
+				{State: StatePaused, CurrentPLID: 1, Rate: 1, Volume: 100},
+			}
+
+			mockClient = &mockClient{
+				getFn: func(endpoint string) ([]byte, error) {
+					require.Equal(t, baseStatus, endpoint)
+
+					index := int(calls.Add(1) - 1)
+					if index >= len(statuses) {
+						index = len(statuses) - 1
+					}
+
+					return json.Marshal(statuses[index])
+				},
+			}
+		)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var (
+			watcher = NewStatusWatcher(NewVLC(mockClient), WithPollInterval(time.Millisecond))
+			events  = watcher.Events()
+
+			callbackEvents = make([]StatusEventType, 0)
+		)
+
+		watcher.OnEvent(func(event StatusEvent) {
+			callbackEvents = append(callbackEvents, event.Type)
+		})
+
+		done := make(chan struct{})
+
+		go func() {
+			defer close(done)
+
+			watcher.Run(ctx)
+		}()
+
+		assert.Equal(t, StateChanged, (<-events).Type)
+		assert.Equal(t, VolumeChanged, (<-events).Type)
+
+		cancel()
+		<-done
+
+		// Make sure the channel is closed on shutdown
+		_, ok := <-events
+		assert.False(t, ok)
+
+		assert.Equal(t, []StatusEventType{StateChanged, VolumeChanged}, callbackEvents)
+	})
+
+	t.Run("watcher can be run again", func(t *testing.T) {
+		t.Parallel()
+
+		mockClient := &mockClient{
+			getFn: func(_ string) ([]byte, error) {
+				return json.Marshal(&Status{State: StatePlaying})
+			},
+		}
+
+		watcher := NewStatusWatcher(NewVLC(mockClient), WithPollInterval(time.Millisecond))
+
+		for run := 0; run < 2; run++ {
+			events := watcher.Events()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			require.NotPanics(t, func() {
+				watcher.Run(ctx)
+			})
+
+			// Each run closes its own channel
+			_, ok := <-events
+			assert.False(t, ok)
+		}
+	})
+
+	t.Run("polling errors are reported", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			fetchErr   = errors.New("fetch error")
+			mockClient = &mockClient{
+				getFn: func(_ string) ([]byte, error) {
+					return nil, fetchErr
+				},
+			}
+		)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var reportedErr error
+
+		watcher := NewStatusWatcher(
+			NewVLC(mockClient),
+			WithPollInterval(time.Millisecond),
+			WithErrorHandler(func(err error) {
+				reportedErr = err
+
+				cancel()
+			}),
+		)
+
+		watcher.Run(ctx)
+
+		assert.ErrorIs(t, reportedErr, fetchErr)
+	})
+}
+
+func TestStatusEventType_String(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "track ended", TrackEnded.String())
+	assert.Equal(t, "unknown", StatusEventType(-1).String())
+}
@@ -102,8 +102,44 @@ type Playlist struct {
 }
 
 type VLM struct {
-	XMLName xml.Name `xml:"vlm"`
-	Error   string   `xml:"error"`
+	XMLName    xml.Name      `xml:"vlm"`
+	Error      string        `xml:"error"`
+	Broadcasts []VLMMedia    `xml:"broadcasts>broadcast"`
+	VODs       []VLMMedia    `xml:"vods>vod"`
+	Schedules  []VLMSchedule `xml:"schedules>schedule"`
+}
+
+type VLMMedia struct {
+	Name      string        `xml:"name,attr"`
+	Mux       string        `xml:"mux,attr,omitempty"` // Only present for VOD media
+	Output    string        `xml:"output"`
+	Inputs    []string      `xml:"inputs>input"`
+	Options   []string      `xml:"options>option"`
+	Instances []VLMInstance `xml:"instances>instance"`
+	Enabled   VLMFlag       `xml:"enabled,attr"`
+	Loop      VLMFlag       `xml:"loop,attr"`
+}
+
+type VLMInstance struct {
+	Name          string  `xml:"name,attr"`
+	State         string  `xml:"state,attr"` // [opening, playing, paused, end, error]
+	Position      float64 `xml:"position,attr"`
+	Time          int64   `xml:"time,attr"`   // microseconds
+	Length        int64   `xml:"length,attr"` // microseconds
+	Rate          float64 `xml:"rate,attr"`
+	Title         int64   `xml:"title,attr"`
+	Chapter       int64   `xml:"chapter,attr"`
+	PlaylistIndex int64   `xml:"playlistindex,attr"`
+	Seekable      bool    `xml:"can-seek,attr"`
+}
+
+type VLMSchedule struct {
+	Name     string   `xml:"name,attr"`
+	Date     string   `xml:"date,attr"`   // [now, YYYY/MM/DD-hh:mm:ss]
+	Period   string   `xml:"period,attr"` // [never, YYYY/MM/DD-hh:mm:ss]
+	Commands []string `xml:"commands>command"`
+	Repeat   int64    `xml:"repeat,attr"` // -1 repeats forever
+	Enabled  VLMFlag  `xml:"enabled,attr"`
 }
 
 type File struct {
@@ -63,6 +63,7 @@ const (
 	subtitleDelayCommand = "subdelay"
 	rateCommand          = "rate"
 	aspectRatioCommand   = "aspectratio"
+	keyCommand           = "key"
 )
 
 // VLC is an instance of the VLC HTTP client
@@ -1,8 +1,9 @@
 package vlc
 
 import (
+	"context"
+	"encoding/xml"
 	"fmt"
-	"net/url"
 
 	"github.com/zivkovicmilos/go-vlc/client"
 )
@@ -12,11 +13,43 @@ const (
 	baseVLMCommand = "requests/vlm_cmd.xml"
 )
 
+const (
+	vlmFlagYes = "yes"
+	vlmFlagNo  = "no"
+)
+
+// VLMFlag is a VLM boolean attribute, encoded as yes / no
+type VLMFlag bool
+
+// MarshalXMLAttr encodes the flag as a yes / no attribute
+func (f VLMFlag) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	value := vlmFlagNo
+	if f {
+		value = vlmFlagYes
+	}
+
+	return xml.Attr{Name: name, Value: value}, nil
+}
+
+// UnmarshalXMLAttr decodes the yes / no attribute
+func (f *VLMFlag) UnmarshalXMLAttr(attr xml.Attr) error {
+	switch attr.Value {
+	case vlmFlagYes, "1", "true":
+		*f = true
+	case vlmFlagNo, "0", "false", "":
+		*f = false
+	default:
+		return fmt.Errorf("invalid VLM flag value, %s", attr.Value)
+	}
+
+	return nil
+}
+
 // executeVLMRequest executes a GET request and parses the response XML
-func (v *VLC) executeVLMRequest(base string, params paramMap) (*VLM, error) {
+func (v *VLC) executeVLMRequest(ctx context.Context, base string, params queryParams) (*VLM, error) {
 	endpoint := buildQueryEndpoint(base, params)
 
-	vlmRaw, err := v.client.Get(endpoint)
+	vlmRaw, err := client.GetWithContext(ctx, v.client, endpoint)
 	if err != nil {
 		return nil, fmt.Errorf("unable to execute request, %s, %w", endpoint, err)
 	}
@@ -26,14 +59,24 @@ func (v *VLC) executeVLMRequest(base string, params paramMap) (*VLM, error) {
 
 // GetVLMElements fetches the full list of VLM elements
 func (v *VLC) GetVLMElements() (*VLM, error) {
-	return v.executeVLMRequest(baseVLM, nil)
+	return v.GetVLMElementsContext(context.Background())
+}
+
+// GetVLMElementsContext is GetVLMElements with a context that controls the request lifetime
+func (v *VLC) GetVLMElementsContext(ctx context.Context) (*VLM, error) {
+	return v.executeVLMRequest(ctx, baseVLM, nil)
 }
 
 // RunVLMCommand executes the given VLM command
 func (v *VLC) RunVLMCommand(command string) (*VLM, error) {
+	return v.RunVLMCommandContext(context.Background(), command)
+}
+
+// RunVLMCommandContext is RunVLMCommand with a context that controls the request lifetime
+func (v *VLC) RunVLMCommandContext(ctx context.Context, command string) (*VLM, error) {
 	params := paramMap{
-		commandKey: url.QueryEscape(command),
+		commandKey: command,
 	}
 
-	return v.executeVLMRequest(baseVLMCommand, params)
+	return v.executeVLMRequest(ctx, baseVLMCommand, params)
 }
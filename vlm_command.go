@@ -0,0 +1,416 @@
+package vlc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var errInvalidVLMName = errors.New("invalid VLM element name")
+
+// VLM command syntax extracted from
+// https://wiki.videolan.org/Documentation:Streaming_HowTo/VLM/
+
+const (
+	vlmNew     = "new"
+	vlmSetup   = "setup"
+	vlmControl = "control"
+	vlmDelete  = "del"
+	vlmShow    = "show"
+	vlmLoad    = "load"
+	vlmSave    = "save"
+
+	vlmBroadcast = "broadcast"
+	vlmVOD       = "vod"
+	vlmSchedule  = "schedule"
+
+	// vlmDateFormat is the VLM schedule date format (YYYY/MM/DD-hh:mm:ss)
+	vlmDateFormat = "2006/01/02-15:04:05"
+)
+
+// VLMError is returned when VLM rejects a typed command
+type VLMError struct {
+	Command string // the rendered command
+	Message string // the VLM error message
+}
+
+// Error returns the string representation of the VLM error
+func (e *VLMError) Error() string {
+	return fmt.Sprintf("VLM command failed, %s, %s", e.Command, e.Message)
+}
+
+// VLMCommand is a VLM command, rendered with correct quoting and escaping
+type VLMCommand struct {
+	args []string
+}
+
+// newVLMCommand creates a new VLM command from the unquoted arguments
+func newVLMCommand(args ...string) VLMCommand {
+	return VLMCommand{
+		args: args,
+	}
+}
+
+// withProperties appends the given properties to the command
+func (c VLMCommand) withProperties(properties []VLMProperty) VLMCommand {
+	args := append([]string(nil), c.args...)
+
+	for _, property := range properties {
+		args = append(args, property.args...)
+	}
+
+	return VLMCommand{
+		args: args,
+	}
+}
+
+// String renders the VLM command
+func (c VLMCommand) String() string {
+	quoted := make([]string, 0, len(c.args))
+
+	for _, arg := range c.args {
+		quoted = append(quoted, quoteVLMArg(arg))
+	}
+
+	return strings.Join(quoted, " ")
+}
+
+// quoteVLMArg quotes the VLM argument, if it contains whitespace, quotes or escapes.
+// Within double quotes, VLM unescapes backslash sequences
+func quoteVLMArg(arg string) string {
+	if arg != "" && !strings.ContainsAny(arg, " \t\r\n\"'\\") {
+		return arg
+	}
+
+	escaped := strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(arg)
+
+	return `"` + escaped + `"`
+}
+
+// VLMProperty is a single VLM media or schedule property
+type VLMProperty struct {
+	args []string
+}
+
+// VLMInput adds the given input MRL to the media
+func VLMInput(mrl string) VLMProperty {
+	return VLMProperty{args: []string{"input", mrl}}
+}
+
+// VLMInputDelete removes the given input MRL from the media
+func VLMInputDelete(mrl string) VLMProperty {
+	return VLMProperty{args: []string{"inputdel", mrl}}
+}
+
+// VLMInputDeleteAll removes all inputs from the media
+func VLMInputDeleteAll() VLMProperty {
+	return VLMProperty{args: []string{"inputdel", "all"}}
+}
+
+// VLMInputDeleteIndex removes the input with the given (1-indexed) number from the media
+func VLMInputDeleteIndex(index int) VLMProperty {
+	return VLMProperty{args: []string{"inputdeln", strconv.Itoa(index)}}
+}
+
+// VLMOutput sets the media stream output (sout) chain
+func VLMOutput(sout string) VLMProperty {
+	return VLMProperty{args: []string{"output", sout}}
+}
+
+// VLMOption adds the given media option, in the form of name or name=value
+func VLMOption(option string) VLMProperty {
+	return VLMProperty{args: []string{"option", option}}
+}
+
+// VLMEnabled enables the media or schedule
+func VLMEnabled() VLMProperty {
+	return VLMProperty{args: []string{"enabled"}}
+}
+
+// VLMDisabled disables the media or schedule
+func VLMDisabled() VLMProperty {
+	return VLMProperty{args: []string{"disabled"}}
+}
+
+// VLMLoop enables looping of the broadcast media
+func VLMLoop() VLMProperty {
+	return VLMProperty{args: []string{"loop"}}
+}
+
+// VLMUnloop disables looping of the broadcast media
+func VLMUnloop() VLMProperty {
+	return VLMProperty{args: []string{"unloop"}}
+}
+
+// VLMMux sets the VOD media muxer
+func VLMMux(mux string) VLMProperty {
+	return VLMProperty{args: []string{"mux", mux}}
+}
+
+// VLMAppend appends the given command to the schedule.
+// VLM treats the rest of the line as the command, so it must be the last property
+func VLMAppend(command VLMCommand) VLMProperty {
+	// The command is passed as a list of arguments
+	return VLMProperty{args: append([]string{"append"}, command.args...)}
+}
+
+// VLMDate sets the schedule start date
+func VLMDate(date time.Time) VLMProperty {
+	return VLMProperty{args: []string{"date", date.Format(vlmDateFormat)}}
+}
+
+// VLMDateNow sets the schedule start date to now
+func VLMDateNow() VLMProperty {
+	return VLMProperty{args: []string{"date", "now"}}
+}
+
+// VLMPeriod sets the schedule repetition period, in seconds precision
+func VLMPeriod(period time.Duration) VLMProperty {
+	var (
+		seconds = int64(period / time.Second)
+
+		days    = seconds / 86400
+		hours   = seconds % 86400 / 3600
+		minutes = seconds % 3600 / 60
+	)
+
+	// Format: years/months/days-hours:minutes:seconds
+	value := fmt.Sprintf("0/0/%d-%d:%d:%d", days, hours, minutes, seconds%60)
+
+	return VLMProperty{args: []string{"period", value}}
+}
+
+// VLMRepeat sets the number of schedule repetitions
+func VLMRepeat(count int) VLMProperty {
+	return VLMProperty{args: []string{"repeat", strconv.Itoa(count)}}
+}
+
+// VLMControlAction is a media control action
+type VLMControlAction struct {
+	args []string
+}
+
+// VLMPlay starts playing the media
+func VLMPlay() VLMControlAction {
+	return VLMControlAction{args: []string{"play"}}
+}
+
+// VLMPlayInput starts playing the media input with the given (1-indexed) number
+func VLMPlayInput(index int) VLMControlAction {
+	return VLMControlAction{args: []string{"play", strconv.Itoa(index)}}
+}
+
+// VLMPause pauses the media
+func VLMPause() VLMControlAction {
+	return VLMControlAction{args: []string{"pause"}}
+}
+
+// VLMStop stops the media
+func VLMStop() VLMControlAction {
+	return VLMControlAction{args: []string{"stop"}}
+}
+
+// VLMSeekPercent seeks the media to the given position [0, 100]
+func VLMSeekPercent(percent float64) VLMControlAction {
+	return VLMControlAction{args: []string{"seek", strconv.FormatFloat(percent, 'f', -1, 64)}}
+}
+
+// VLMSeekTime seeks the media to the given absolute time, in milliseconds precision
+func VLMSeekTime(position time.Duration) VLMControlAction {
+	return VLMControlAction{args: []string{"seek", strconv.FormatInt(position.Milliseconds(), 10) + "ms"}}
+}
+
+// VLMSeekRelative seeks the media forward (or backward, if negative), in milliseconds precision
+func VLMSeekRelative(offset time.Duration) VLMControlAction {
+	var (
+		ms   = offset.Milliseconds()
+		sign = "+"
+	)
+
+	if ms < 0 {
+		sign = "-"
+		ms = -ms
+	}
+
+	return VLMControlAction{args: []string{"seek", sign + strconv.FormatInt(ms, 10) + "ms"}}
+}
+
+// NewBroadcastCommand creates the command for a new broadcast media
+func NewBroadcastCommand(name string, properties ...VLMProperty) VLMCommand {
+	return newVLMCommand(vlmNew, name, vlmBroadcast).withProperties(properties)
+}
+
+// NewVODCommand creates the command for a new video on demand media
+func NewVODCommand(name string, properties ...VLMProperty) VLMCommand {
+	return newVLMCommand(vlmNew, name, vlmVOD).withProperties(properties)
+}
+
+// NewScheduleCommand creates the command for a new schedule
+func NewScheduleCommand(name string, properties ...VLMProperty) VLMCommand {
+	return newVLMCommand(vlmNew, name, vlmSchedule).withProperties(properties)
+}
+
+// SetupCommand creates the command for changing the media or schedule properties
+func SetupCommand(name string, properties ...VLMProperty) VLMCommand {
+	return newVLMCommand(vlmSetup, name).withProperties(properties)
+}
+
+// ControlCommand creates the command for controlling the media playback
+func ControlCommand(name string, action VLMControlAction) VLMCommand {
+	return newVLMCommand(append([]string{vlmControl, name}, action.args...)...)
+}
+
+// DeleteCommand creates the command for deleting the media or schedule.
+// The name can also be one of: all, media, schedule
+func DeleteCommand(name string) VLMCommand {
+	return newVLMCommand(vlmDelete, name)
+}
+
+// ShowCommand creates the command for showing the media or schedule.
+// If the name is empty, all elements are shown
+func ShowCommand(name string) VLMCommand {
+	if name == "" {
+		return newVLMCommand(vlmShow)
+	}
+
+	return newVLMCommand(vlmShow, name)
+}
+
+// LoadCommand creates the command for loading a VLM configuration file
+func LoadCommand(path string) VLMCommand {
+	return newVLMCommand(vlmLoad, path)
+}
+
+// SaveCommand creates the command for saving the VLM configuration to a file
+func SaveCommand(path string) VLMCommand {
+	return newVLMCommand(vlmSave, path)
+}
+
+// ExecuteVLMCommand executes the given typed VLM command.
+// If VLM rejects the command, a *VLMError is returned
+func (v *VLC) ExecuteVLMCommand(command VLMCommand) (*VLM, error) {
+	return v.ExecuteVLMCommandContext(context.Background(), command)
+}
+
+// ExecuteVLMCommandContext is ExecuteVLMCommand with a context that controls the request lifetime
+func (v *VLC) ExecuteVLMCommandContext(ctx context.Context, command VLMCommand) (*VLM, error) {
+	rendered := command.String()
+
+	vlm, err := v.RunVLMCommandContext(ctx, rendered)
+	if err != nil {
+		return nil, err
+	}
+
+	if vlm.Error != "" {
+		return nil, &VLMError{
+			Command: rendered,
+			Message: vlm.Error,
+		}
+	}
+
+	return vlm, nil
+}
+
+// executeNamedVLMCommand validates the element name, and executes the VLM command
+func (v *VLC) executeNamedVLMCommand(ctx context.Context, name string, command VLMCommand) (*VLM, error) {
+	if strings.TrimSpace(name) == "" {
+		return nil, errInvalidVLMName
+	}
+
+	return v.ExecuteVLMCommandContext(ctx, command)
+}
+
+// NewBroadcast creates a new broadcast media with the given properties
+func (v *VLC) NewBroadcast(name string, properties ...VLMProperty) (*VLM, error) {
+	return v.NewBroadcastContext(context.Background(), name, properties...)
+}
+
+// NewBroadcastContext is NewBroadcast with a context that controls the request lifetime
+func (v *VLC) NewBroadcastContext(ctx context.Context, name string, properties ...VLMProperty) (*VLM, error) {
+	return v.executeNamedVLMCommand(ctx, name, NewBroadcastCommand(name, properties...))
+}
+
+// NewVOD creates a new video on demand media with the given properties
+func (v *VLC) NewVOD(name string, properties ...VLMProperty) (*VLM, error) {
+	return v.NewVODContext(context.Background(), name, properties...)
+}
+
+// NewVODContext is NewVOD with a context that controls the request lifetime
+func (v *VLC) NewVODContext(ctx context.Context, name string, properties ...VLMProperty) (*VLM, error) {
+	return v.executeNamedVLMCommand(ctx, name, NewVODCommand(name, properties...))
+}
+
+// NewSchedule creates a new schedule with the given properties
+func (v *VLC) NewSchedule(name string, properties ...VLMProperty) (*VLM, error) {
+	return v.NewScheduleContext(context.Background(), name, properties...)
+}
+
+// NewScheduleContext is NewSchedule with a context that controls the request lifetime
+func (v *VLC) NewScheduleContext(ctx context.Context, name string, properties ...VLMProperty) (*VLM, error) {
+	return v.executeNamedVLMCommand(ctx, name, NewScheduleCommand(name, properties...))
+}
+
+// Setup changes the properties of the media or schedule with the given name
+func (v *VLC) Setup(name string, properties ...VLMProperty) (*VLM, error) {
+	return v.SetupContext(context.Background(), name, properties...)
+}
+
+// SetupContext is Setup with a context that controls the request lifetime
+func (v *VLC) SetupContext(ctx context.Context, name string, properties ...VLMProperty) (*VLM, error) {
+	return v.executeNamedVLMCommand(ctx, name, SetupCommand(name, properties...))
+}
+
+// Control controls the playback of the media with the given name
+func (v *VLC) Control(name string, action VLMControlAction) (*VLM, error) {
+	return v.ControlContext(context.Background(), name, action)
+}
+
+// ControlContext is Control with a context that controls the request lifetime
+func (v *VLC) ControlContext(ctx context.Context, name string, action VLMControlAction) (*VLM, error) {
+	return v.executeNamedVLMCommand(ctx, name, ControlCommand(name, action))
+}
+
+// Delete deletes the media or schedule with the given name.
+// The name can also be one of: all, media, schedule
+func (v *VLC) Delete(name string) (*VLM, error) {
+	return v.DeleteContext(context.Background(), name)
+}
+
+// DeleteContext is Delete with a context that controls the request lifetime
+func (v *VLC) DeleteContext(ctx context.Context, name string) (*VLM, error) {
+	return v.executeNamedVLMCommand(ctx, name, DeleteCommand(name))
+}
+
+// Show shows the media or schedule with the given name.
+// If the name is empty, all elements are shown
+func (v *VLC) Show(name string) (*VLM, error) {
+	return v.ShowContext(context.Background(), name)
+}
+
+// ShowContext is Show with a context that controls the request lifetime
+func (v *VLC) ShowContext(ctx context.Context, name string) (*VLM, error) {
+	return v.ExecuteVLMCommandContext(ctx, ShowCommand(name))
+}
+
+// Load loads the VLM configuration file at the given path (on the VLC host)
+func (v *VLC) Load(path string) (*VLM, error) {
+	return v.LoadContext(context.Background(), path)
+}
+
+// LoadContext is Load with a context that controls the request lifetime
+func (v *VLC) LoadContext(ctx context.Context, path string) (*VLM, error) {
+	return v.ExecuteVLMCommandContext(ctx, LoadCommand(path))
+}
+
+// Save saves the VLM configuration to the file at the given path (on the VLC host)
+func (v *VLC) Save(path string) (*VLM, error) {
+	return v.SaveContext(context.Background(), path)
+}
+
+// SaveContext is Save with a context that controls the request lifetime
+func (v *VLC) SaveContext(ctx context.Context, path string) (*VLM, error) {
+	return v.ExecuteVLMCommandContext(ctx, SaveCommand(path))
+}
@@ -0,0 +1,305 @@
+package vlc
+
+import (
+	"encoding/xml"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVLMCommand_String(t *testing.T) {
+	t.Parallel()
+
+	testTable := []struct {
+		name     string
+		command  VLMCommand
+		expected string
+	}{
+		{
+			"new broadcast",
+			NewBroadcastCommand(
+				"movie",
+				VLMEnabled(),
+				VLMInput("file:///media/movie.mkv"),
+				VLMOutput("#std{access=http,mux=ts,dst=:8081/movie}"),
+				VLMLoop(),
+			),
+			"new movie broadcast enabled input file:///media/movie.mkv " +
+				"output #std{access=http,mux=ts,dst=:8081/movie} loop",
+		},
+		{
+			"quoted arguments",
+			NewBroadcastCommand(
+				"my movie",
+				VLMInput(`file:///media/a "quoted" \ movie.mkv`),
+				VLMOption("sout-keep"),
+			),
+			`new "my movie" broadcast input "file:///media/a \"quoted\" \\ movie.mkv" option sout-keep`,
+		},
+		{
+			"new VOD",
+			NewVODCommand("library", VLMInput("file:///media/library.mkv"), VLMMux("ts"), VLMDisabled()),
+			"new library vod input file:///media/library.mkv mux ts disabled",
+		},
+		{
+			"new schedule",
+			NewScheduleCommand(
+				"nightly",
+				VLMDate(time.Date(2024, 1, 2, 22, 0, 0, 0, time.UTC)),
+				VLMPeriod(36*time.Hour+90*time.Second),
+				VLMRepeat(-1),
+				VLMAppend(ControlCommand("movie", VLMPlay())),
+			),
+			"new nightly schedule date 2024/01/02-22:00:00 period 0/0/1-12:1:30 repeat -1 append control movie play",
+		},
+		{
+			"setup",
+			SetupCommand("movie", VLMInputDeleteAll(), VLMInputDeleteIndex(2), VLMInputDelete("file:///a"), VLMUnloop()),
+			"setup movie inputdel all inputdeln 2 inputdel file:///a unloop",
+		},
+		{
+			"control play input",
+			ControlCommand("movie", VLMPlayInput(2)),
+			"control movie play 2",
+		},
+		{
+			"control pause",
+			ControlCommand("movie", VLMPause()),
+			"control movie pause",
+		},
+		{
+			"control stop",
+			ControlCommand("movie", VLMStop()),
+			"control movie stop",
+		},
+		{
+			"control seek percent",
+			ControlCommand("movie", VLMSeekPercent(12.5)),
+			"control movie seek 12.5",
+		},
+		{
+			"control seek time",
+			ControlCommand("movie", VLMSeekTime(90*time.Second)),
+			"control movie seek 90000ms",
+		},
+		{
+			"control seek backward",
+			ControlCommand("movie", VLMSeekRelative(-1500*time.Millisecond)),
+			"control movie seek -1500ms",
+		},
+		{
+			"delete",
+			DeleteCommand("all"),
+			"del all",
+		},
+		{
+			"show all",
+			ShowCommand(""),
+			"show",
+		},
+		{
+			"show media",
+			ShowCommand("movie"),
+			"show movie",
+		},
+		{
+			"load",
+			LoadCommand("/home/user/vlm config.vlm"),
+			`load "/home/user/vlm config.vlm"`,
+		},
+		{
+			"save",
+			SaveCommand("/tmp/vlm.vlm"),
+			"save /tmp/vlm.vlm",
+		},
+	}
+
+	for _, testCase := range testTable {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, testCase.expected, testCase.command.String())
+		})
+	}
+}
+
+func TestVLC_NewBroadcast(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid name", func(t *testing.T) {
+		t.Parallel()
+
+		vlc := NewVLC(&mockClient{})
+
+		vlm, err := vlc.NewBroadcast(" ")
+
+		assert.Nil(t, vlm)
+		assert.ErrorIs(t, err, errInvalidVLMName)
+	})
+
+	t.Run("broadcast created", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			command = NewBroadcastCommand("movie", VLMInput("file:///media/a movie.mkv"))
+
+			expectedParams = paramMap{
+				commandKey: command.String(),
+			}
+
+			expectedVLM = &VLM{
+				XMLName: xml.Name{
+					Local: "vlm",
+				},
+			}
+
+			mockClient = &mockClient{
+				getFn: func(endpoint string) ([]byte, error) {
+					require.Equal(
+						t,
+						buildQueryEndpoint(baseVLMCommand, expectedParams),
+						endpoint,
+					)
+
+					return xml.Marshal(expectedVLM)
+				},
+			}
+		)
+
+		vlc := NewVLC(mockClient)
+
+		vlm, err := vlc.NewBroadcast("movie", VLMInput("file:///media/a movie.mkv"))
+		require.NoError(t, err)
+
+		assert.Equal(t, expectedVLM, vlm)
+	})
+
+	t.Run("broadcast rejected", func(t *testing.T) {
+		t.Parallel()
+
+		mockClient := &mockClient{
+			getFn: func(_ string) ([]byte, error) {
+				return xml.Marshal(&VLM{
+					Error: "new: Name already in use",
+				})
+			},
+		}
+
+		vlc := NewVLC(mockClient)
+
+		vlm, err := vlc.NewBroadcast("movie")
+
+		assert.Nil(t, vlm)
+
+		var vlmErr *VLMError
+
+		require.True(t, errors.As(err, &vlmErr))
+		assert.Equal(t, "new movie broadcast", vlmErr.Command)
+		assert.Equal(t, "new: Name already in use", vlmErr.Message)
+	})
+}
+
+func TestVLC_VLMCommands(t *testing.T) {
+	t.Parallel()
+
+	testTable := []struct {
+		name            string
+		commandFn       func(vlc *VLC) (*VLM, error)
+		expectedCommand string
+	}{
+		{
+			"new VOD",
+			func(vlc *VLC) (*VLM, error) {
+				return vlc.NewVOD("library")
+			},
+			"new library vod",
+		},
+		{
+			"new schedule",
+			func(vlc *VLC) (*VLM, error) {
+				return vlc.NewSchedule("nightly", VLMDateNow())
+			},
+			"new nightly schedule date now",
+		},
+		{
+			"setup",
+			func(vlc *VLC) (*VLM, error) {
+				return vlc.Setup("movie", VLMEnabled())
+			},
+			"setup movie enabled",
+		},
+		{
+			"control",
+			func(vlc *VLC) (*VLM, error) {
+				return vlc.Control("movie", VLMPlay())
+			},
+			"control movie play",
+		},
+		{
+			"delete",
+			func(vlc *VLC) (*VLM, error) {
+				return vlc.Delete("movie")
+			},
+			"del movie",
+		},
+		{
+			"show",
+			func(vlc *VLC) (*VLM, error) {
+				return vlc.Show("")
+			},
+			"show",
+		},
+		{
+			"load",
+			func(vlc *VLC) (*VLM, error) {
+				return vlc.Load("/tmp/vlm.vlm")
+			},
+			"load /tmp/vlm.vlm",
+		},
+		{
+			"save",
+			func(vlc *VLC) (*VLM, error) {
+				return vlc.Save("/tmp/vlm.vlm")
+			},
+			"save /tmp/vlm.vlm",
+		},
+	}
+
+	for _, testCase := range testTable {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				expectedParams = paramMap{
+					commandKey: testCase.expectedCommand,
+				}
+
+				mockClient = &mockClient{
+					getFn: func(endpoint string) ([]byte, error) {
+						require.Equal(
+							t,
+							buildQueryEndpoint(baseVLMCommand, expectedParams),
+							endpoint,
+						)
+
+						return xml.Marshal(&VLM{})
+					},
+				}
+			)
+
+			vlc := NewVLC(mockClient)
+
+			vlm, err := testCase.commandFn(vlc)
+			require.NoError(t, err)
+
+			assert.NotNil(t, vlm)
+		})
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"encoding/xml"
 	"errors"
 	"fmt"
-	"net/url"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -65,6 +64,133 @@ func TestVLC_GetVLMElements(t *testing.T) {
 	})
 }
 
+func TestVLC_GetVLMElements_Parsed(t *testing.T) {
+	t.Parallel()
+
+	var (
+		rawVLM = `<?xml version="1.0" encoding="utf-8" standalone="yes" ?>
+<vlm>
+	<broadcasts>
+		<broadcast name="movie" enabled="yes" loop="no">
+			<output>#std{access=http,mux=ts,dst=:8081/movie}</output>
+			<inputs>
+				<input>file:///media/movie.mkv</input>
+				<input>file:///media/trailer.mkv</input>
+			</inputs>
+			<options>
+				<option>sout-keep</option>
+			</options>
+			<instances>
+				<instance name="default" state="playing" position="0.25" time="15000000"
+					length="60000000" rate="1.000" title="0" chapter="1" can-seek="1" playlistindex="1"/>
+			</instances>
+		</broadcast>
+	</broadcasts>
+	<vods>
+		<vod name="library" enabled="no" loop="yes" mux="ts">
+			<output></output>
+			<inputs>
+				<input>file:///media/library.mkv</input>
+			</inputs>
+			<options></options>
+			<instances></instances>
+		</vod>
+	</vods>
+	<schedules>
+		<schedule name="nightly" enabled="yes" date="2024/01/01-22:00:00" period="0/0/1-0:0:0" repeat="-1">
+			<commands>
+				<command>control movie play</command>
+			</commands>
+		</schedule>
+	</schedules>
+</vlm>`
+
+		expectedVLM = &VLM{
+			XMLName: xml.Name{
+				Local: "vlm",
+			},
+			Broadcasts: []VLMMedia{
+				{
+					Name:    "movie",
+					Enabled: true,
+					Loop:    false,
+					Output:  "#std{access=http,mux=ts,dst=:8081/movie}",
+					Inputs: []string{
+						"file:///media/movie.mkv",
+						"file:///media/trailer.mkv",
+					},
+					Options: []string{"sout-keep"},
+					Instances: []VLMInstance{
+						{
+							Name:          "default",
+							State:         "playing",
+							Position:      0.25,
+							Time:          15000000,
+							Length:        60000000,
+							Rate:          1,
+							Title:         0,
+							Chapter:       1,
+							Seekable:      true,
+							PlaylistIndex: 1,
+						},
+					},
+				},
+			},
+			VODs: []VLMMedia{
+				{
+					Name:    "library",
+					Enabled: false,
+					Loop:    true,
+					Mux:     "ts",
+					Inputs:  []string{"file:///media/library.mkv"},
+				},
+			},
+			Schedules: []VLMSchedule{
+				{
+					Name:     "nightly",
+					Enabled:  true,
+					Date:     "2024/01/01-22:00:00",
+					Period:   "0/0/1-0:0:0",
+					Repeat:   -1,
+					Commands: []string{"control movie play"},
+				},
+			},
+		}
+
+		mockClient = &mockClient{
+			getFn: func(endpoint string) ([]byte, error) {
+				require.Equal(t, baseVLM, endpoint)
+
+				return []byte(rawVLM), nil
+			},
+		}
+	)
+
+	vlc := NewVLC(mockClient)
+
+	vlm, err := vlc.GetVLMElements()
+	require.NoError(t, err)
+
+	assert.Equal(t, expectedVLM, vlm)
+
+	// Make sure the model survives an encoding round trip
+	encodedVLM, err := xml.Marshal(vlm)
+	require.NoError(t, err)
+
+	var decodedVLM VLM
+
+	require.NoError(t, xml.Unmarshal(encodedVLM, &decodedVLM))
+	assert.Equal(t, expectedVLM, &decodedVLM)
+}
+
+func TestVLMFlag_UnmarshalXMLAttr(t *testing.T) {
+	t.Parallel()
+
+	var flag VLMFlag
+
+	assert.Error(t, flag.UnmarshalXMLAttr(xml.Attr{Value: "maybe"}))
+}
+
 func TestVLC_RunVLMCommand(t *testing.T) {
 	t.Parallel()
 
@@ -74,7 +200,7 @@ func TestVLC_RunVLMCommand(t *testing.T) {
 		var (
 			command        = "command"
 			expectedParams = paramMap{
-				commandKey: url.QueryEscape(command),
+				commandKey: command,
 			}
 
 			fetchErr   = errors.New("fetch error")
@@ -99,13 +225,34 @@ func TestVLC_RunVLMCommand(t *testing.T) {
 		assert.ErrorIs(t, err, fetchErr)
 	})
 
+	t.Run("VLM command encoded once", func(t *testing.T) {
+		t.Parallel()
+
+		mockClient := &mockClient{
+			getFn: func(endpoint string) ([]byte, error) {
+				require.Equal(
+					t,
+					baseVLMCommand+"?command=setup%20movie%20input%20%22file%3A%2F%2F%2Fa%20%26%20b.mkv%22",
+					endpoint,
+				)
+
+				return xml.Marshal(&VLM{})
+			},
+		}
+
+		vlc := NewVLC(mockClient)
+
+		_, err := vlc.RunVLMCommand(`setup movie input "file:///a & b.mkv"`)
+		require.NoError(t, err)
+	})
+
 	t.Run("VLM command ran with no errors", func(t *testing.T) {
 		t.Parallel()
 
 		var (
 			command        = "example"
 			expectedParams = paramMap{
-				commandKey: url.QueryEscape(command),
+				commandKey: command,
 			}
 
 			expectedVLM = &VLM{
@@ -142,7 +289,7 @@ func TestVLC_RunVLMCommand(t *testing.T) {
 		var (
 			command        = "unknown"
 			expectedParams = paramMap{
-				commandKey: url.QueryEscape(command),
+				commandKey: command,
 			}
 
 			expectedVLM = &VLM{
@@ -0,0 +1,158 @@
+package vlc
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	defaultPollInterval  = time.Second
+	defaultSeekThreshold = 3 * time.Second
+	defaultEventBuffer   = 16
+)
+
+// WatcherOption is a functional option for the status and playlist watchers
+type WatcherOption func(*watcherConfig)
+
+// watcherConfig holds the shared watcher configuration
+type watcherConfig struct {
+	onError       func(error)
+	interval      time.Duration
+	seekThreshold time.Duration
+}
+
+// newWatcherConfig creates the watcher configuration, with the given options applied
+func newWatcherConfig(opts []WatcherOption) *watcherConfig {
+	cfg := &watcherConfig{
+		onError:       func(error) {},
+		interval:      defaultPollInterval,
+		seekThreshold: defaultSeekThreshold,
+	}
+
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	return cfg
+}
+
+// WithPollInterval sets the interval between consecutive polls.
+// Non-positive intervals are ignored, and the default interval is used
+func WithPollInterval(interval time.Duration) WatcherOption {
+	return func(c *watcherConfig) {
+		if interval <= 0 {
+			interval = defaultPollInterval
+		}
+
+		c.interval = interval
+	}
+}
+
+// WithSeekThreshold sets the minimum difference between the expected and
+// the reported playback time, for it to be considered a seek
+func WithSeekThreshold(threshold time.Duration) WatcherOption {
+	return func(c *watcherConfig) {
+		c.seekThreshold = threshold
+	}
+}
+
+// WithErrorHandler sets the handler for polling errors.
+// Polling errors don't stop the watcher, and are ignored by default
+func WithErrorHandler(onError func(error)) WatcherOption {
+	return func(c *watcherConfig) {
+		c.onError = onError
+	}
+}
+
+// poll calls the given function at the configured interval, until the context is done
+func (c *watcherConfig) poll(ctx context.Context, fn func(ctx context.Context, now time.Time)) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		fn(ctx, time.Now())
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// emitter delivers the watcher events to the registered callbacks and channel
+type emitter[E any] struct {
+	events   chan E
+	handlers []func(E)
+	mu       sync.Mutex
+}
+
+// OnEvent registers a callback for every event.
+// Callbacks are called sequentially, from the Run goroutine
+func (e *emitter[E]) OnEvent(handler func(E)) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.handlers = append(e.handlers, handler)
+}
+
+// Events returns the event channel of the next Run, which is closed once that Run returns.
+// It must be called before each Run, and the channel must be drained
+func (e *emitter[E]) Events() <-chan E {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.events == nil {
+		e.events = make(chan E, defaultEventBuffer)
+	}
+
+	return e.events
+}
+
+// subscribe snapshots the registered callbacks, and takes over the channel.
+// The next Events call creates a new channel, for the next Run
+func (e *emitter[E]) subscribe() *subscription[E] {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	events := e.events
+	e.events = nil
+
+	return &subscription[E]{
+		handlers: append([]func(E){}, e.handlers...),
+		events:   events,
+	}
+}
+
+// subscription is a snapshot of the event subscribers, for a single Run
+type subscription[E any] struct {
+	events   chan E
+	handlers []func(E)
+}
+
+// emit delivers the event to the subscribers.
+// It returns false if the context is done before the event is delivered
+func (s *subscription[E]) emit(ctx context.Context, event E) bool {
+	for _, handler := range s.handlers {
+		handler(event)
+	}
+
+	if s.events == nil {
+		return true
+	}
+
+	select {
+	case s.events <- event:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// close closes the event channel, if any
+func (s *subscription[E]) close() {
+	if s.events != nil {
+		close(s.events)
+	}
+}
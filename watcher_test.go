@@ -0,0 +1,34 @@
+package vlc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithPollInterval(t *testing.T) {
+	t.Parallel()
+
+	testTable := []struct {
+		name     string
+		interval time.Duration
+		expected time.Duration
+	}{
+		{"positive interval", 250 * time.Millisecond, 250 * time.Millisecond},
+		{"zero interval", 0, defaultPollInterval},
+		{"negative interval", -time.Second, defaultPollInterval},
+	}
+
+	for _, testCase := range testTable {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := newWatcherConfig([]WatcherOption{WithPollInterval(testCase.interval)})
+
+			assert.Equal(t, testCase.expected, cfg.interval)
+		})
+	}
+}
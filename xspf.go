@@ -0,0 +1,410 @@
+package vlc
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+)
+
+var errUnresolvableLocation = errors.New("unable to resolve location")
+
+const (
+	xspfNamespace        = "http://xspf.org/ns/0/"
+	xspfVLCNamespace     = "http://www.videolan.org/vlc/playlist/ns/0/"
+	xspfVLCApplication   = "http://www.videolan.org/vlc/playlist/0"
+	xspfVLCPrefix        = "vlc"
+	xspfVersion          = "1"
+	xspfUnknownDuration  = -1
+	xspfMillisPerSeconds = 1000
+)
+
+var (
+	xspfNodeName = xml.Name{Local: xspfVLCPrefix + ":node"}
+	xspfItemName = xml.Name{Local: xspfVLCPrefix + ":item"}
+)
+
+// xspfPlaylist is the XSPF document, with the VLC extensions.
+// VLC elements are named with the vlc: prefix (see xspfTokenReader),
+// and the field order is the XSPF element order
+type xspfPlaylist struct {
+	XMLName   xml.Name       `xml:"playlist"`
+	Namespace string         `xml:"xmlns,attr"`
+	VLC       string         `xml:"xmlns:vlc,attr"`
+	Version   string         `xml:"version,attr"`
+	Title     string         `xml:"title,omitempty"`
+	Tracks    []xspfTrack    `xml:"trackList>track"`
+	Extension *xspfExtension `xml:"extension,omitempty"`
+}
+
+// xspfTrack is a single XSPF track (playlist leaf).
+// The field order is the XSPF element order
+type xspfTrack struct {
+	Location  string              `xml:"location"`
+	Title     string              `xml:"title,omitempty"`
+	Duration  int64               `xml:"duration,omitempty"` // milliseconds
+	Extension *xspfTrackExtension `xml:"extension,omitempty"`
+}
+
+// xspfTrackExtension is the VLC track extension, holding the track ID
+// referenced from the playlist tree
+type xspfTrackExtension struct {
+	ID          *int   `xml:"vlc:id"`
+	Application string `xml:"application,attr"`
+}
+
+// xspfExtension is the VLC playlist extension, holding the playlist tree
+type xspfExtension struct {
+	Application string     `xml:"application,attr"`
+	Children    []xspfNode `xml:",any"`
+}
+
+// xspfNode is either a vlc:node, with a title and children, or a vlc:item referencing a track
+type xspfNode struct {
+	TrackID  *int       `xml:"tid,attr"`
+	XMLName  xml.Name   `xml:""`
+	Title    string     `xml:"title,attr,omitempty"`
+	Children []xspfNode `xml:",any"`
+}
+
+// xspfTokenReader renames elements in the VLC namespace to their prefixed form (vlc:node),
+// so the same struct tags are used for both encoding and decoding
+type xspfTokenReader struct {
+	decoder *xml.Decoder
+}
+
+// Token returns the next XML token, with the VLC elements renamed
+func (r *xspfTokenReader) Token() (xml.Token, error) {
+	token, err := r.decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	rename := func(name xml.Name) xml.Name {
+		if name.Space == xspfVLCNamespace || name.Space == xspfVLCPrefix {
+			return xml.Name{Local: xspfVLCPrefix + ":" + name.Local}
+		}
+
+		return name
+	}
+
+	switch t := token.(type) {
+	case xml.StartElement:
+		t.Name = rename(t.Name)
+
+		return t, nil
+	case xml.EndElement:
+		t.Name = rename(t.Name)
+
+		return t, nil
+	default:
+		return token, nil
+	}
+}
+
+// ParseXSPF parses an XSPF playlist into a playlist tree.
+// The VLC node extensions are kept as nodes, and tracks that aren't part
+// of the tree are added at the root level.
+// Parsed items don't have IDs, and unknown durations are -1
+func ParseXSPF(r io.Reader) (*Playlist, error) {
+	var (
+		doc     xspfPlaylist
+		decoder = xml.NewTokenDecoder(&xspfTokenReader{decoder: xml.NewDecoder(r)})
+	)
+
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("unable to decode XSPF, %w", err)
+	}
+
+	return doc.playlist(), nil
+}
+
+// playlist converts the XSPF document into a playlist tree
+func (d *xspfPlaylist) playlist() *Playlist {
+	var (
+		tracks     = make(map[int]*xspfTrack, len(d.Tracks))
+		referenced = make(map[int]struct{}, len(d.Tracks))
+	)
+
+	for i := range d.Tracks {
+		tracks[d.trackID(i)] = &d.Tracks[i]
+	}
+
+	var convert func(nodes []xspfNode) []Playlist
+
+	convert = func(nodes []xspfNode) []Playlist {
+		items := make([]Playlist, 0, len(nodes))
+
+		for _, node := range nodes {
+			// The decoder puts the renamed elements in the default namespace
+			switch node.XMLName.Local {
+			case xspfNodeName.Local:
+				items = append(items, Playlist{
+					Type:     playlistTypeNode,
+					Name:     node.Title,
+					Children: convert(node.Children),
+				})
+			case xspfItemName.Local:
+				if node.TrackID == nil {
+					continue
+				}
+
+				track, ok := tracks[*node.TrackID]
+				if !ok {
+					continue
+				}
+
+				referenced[*node.TrackID] = struct{}{}
+
+				items = append(items, track.playlist())
+			}
+		}
+
+		return items
+	}
+
+	root := &Playlist{
+		Type:     playlistTypeNode,
+		Name:     d.Title,
+		Children: make([]Playlist, 0, len(d.Tracks)),
+	}
+
+	if d.Extension != nil {
+		root.Children = append(root.Children, convert(d.Extension.Children)...)
+	}
+
+	for i := range d.Tracks {
+		if _, ok := referenced[d.trackID(i)]; !ok {
+			root.Children = append(root.Children, d.Tracks[i].playlist())
+		}
+	}
+
+	return root
+}
+
+// trackID returns the ID the tree references the track by,
+// which is its VLC ID, or its position if it doesn't have one
+func (d *xspfPlaylist) trackID(index int) int {
+	if ext := d.Tracks[index].Extension; ext != nil && ext.ID != nil {
+		return *ext.ID
+	}
+
+	return index
+}
+
+// playlist converts the track into a playlist leaf
+func (t *xspfTrack) playlist() Playlist {
+	duration := int64(xspfUnknownDuration)
+	if t.Duration > 0 {
+		duration = t.Duration / xspfMillisPerSeconds
+	}
+
+	return Playlist{
+		Type:     playlistTypeLeaf,
+		Name:     t.Title,
+		URI:      t.Location,
+		Duration: duration,
+	}
+}
+
+// WriteXSPF writes the playlist as an XSPF playlist, with the nodes as VLC extensions.
+// Only the play queue ("Playlist" node) is written, if the playlist is the full tree
+func WriteXSPF(w io.Writer, playlist *Playlist) error {
+	root := playlist
+	if node := playlist.PlaylistNode(); node != nil {
+		root = node
+	}
+
+	doc := &xspfPlaylist{
+		Namespace: xspfNamespace,
+		VLC:       xspfVLCNamespace,
+		Version:   xspfVersion,
+		Title:     root.Name,
+		Tracks:    make([]xspfTrack, 0),
+		Extension: &xspfExtension{
+			Application: xspfVLCApplication,
+		},
+	}
+
+	children := root.Children
+	if root.IsLeaf() {
+		children = []Playlist{*root}
+	}
+
+	doc.Extension.Children = doc.addItems(children)
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return fmt.Errorf("unable to write XSPF, %w", err)
+	}
+
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "\t")
+
+	if err := encoder.Encode(doc); err != nil {
+		return fmt.Errorf("unable to write XSPF, %w", err)
+	}
+
+	if _, err := io.WriteString(w, "\n"); err != nil {
+		return fmt.Errorf("unable to write XSPF, %w", err)
+	}
+
+	return nil
+}
+
+// addItems adds the playlist leaves as tracks, and returns the matching extension nodes
+func (d *xspfPlaylist) addItems(items []Playlist) []xspfNode {
+	nodes := make([]xspfNode, 0, len(items))
+
+	for i := range items {
+		item := &items[i]
+
+		if !item.IsLeaf() {
+			nodes = append(nodes, xspfNode{
+				XMLName:  xspfNodeName,
+				Title:    item.Name,
+				Children: d.addItems(item.Children),
+			})
+
+			continue
+		}
+
+		trackID := len(d.Tracks)
+
+		track := xspfTrack{
+			Location: item.URI,
+			Title:    item.Name,
+			Extension: &xspfTrackExtension{
+				Application: xspfVLCApplication,
+				ID:          &trackID,
+			},
+		}
+
+		if item.Duration > 0 {
+			track.Duration = item.Duration * xspfMillisPerSeconds
+		}
+
+		d.Tracks = append(d.Tracks, track)
+
+		nodes = append(nodes, xspfNode{
+			XMLName: xspfItemName,
+			TrackID: &trackID,
+		})
+	}
+
+	return nodes
+}
+
+// XSPFItemError is a failure to enqueue a single XSPF track
+type XSPFItemError struct {
+	Err  error
+	Item Playlist
+}
+
+// Error returns the string representation of the item error
+func (e *XSPFItemError) Error() string {
+	return fmt.Sprintf("unable to enqueue %s, %s", e.Item.URI, e.Err)
+}
+
+// Unwrap returns the underlying enqueue error
+func (e *XSPFItemError) Unwrap() error {
+	return e.Err
+}
+
+// XSPFImportResult is the outcome of an XSPF import
+type XSPFImportResult struct {
+	Enqueued []Playlist      // the tracks enqueued, with resolved locations
+	Failed   []XSPFItemError // the tracks that couldn't be resolved or enqueued
+}
+
+// ExportXSPF writes the current playlist as an XSPF playlist
+func (v *VLC) ExportXSPF(w io.Writer) error {
+	return v.ExportXSPFContext(context.Background(), w)
+}
+
+// ExportXSPFContext is ExportXSPF with a context that controls the request lifetime
+func (v *VLC) ExportXSPFContext(ctx context.Context, w io.Writer) error {
+	playlist, err := v.GetPlaylistContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	return WriteXSPF(w, playlist)
+}
+
+// ImportXSPF parses the XSPF playlist, and enqueues every track in tree order.
+// The HTTP interface can't create playlist nodes, so the tree is enqueued flat.
+// Relative locations are resolved against the base URI, which can be empty if all the locations are absolute.
+//
+// Tracks that can't be resolved or enqueued are reported in the result, and don't stop the import
+func (v *VLC) ImportXSPF(r io.Reader, baseURI string) (*XSPFImportResult, error) {
+	return v.ImportXSPFContext(context.Background(), r, baseURI)
+}
+
+// ImportXSPFContext is ImportXSPF with a context that controls the request lifetime
+func (v *VLC) ImportXSPFContext(ctx context.Context, r io.Reader, baseURI string) (*XSPFImportResult, error) {
+	playlist, err := ParseXSPF(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var base *url.URL
+
+	if baseURI != "" {
+		if base, err = url.Parse(baseURI); err != nil {
+			return nil, fmt.Errorf("%w, %w", errInvalidBaseURI, err)
+		}
+	}
+
+	result := &XSPFImportResult{
+		Enqueued: make([]Playlist, 0),
+		Failed:   make([]XSPFItemError, 0),
+	}
+
+	for _, leaf := range playlist.Leaves() {
+		// Stop early if the context is done, instead of failing every track
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return result, ctxErr
+		}
+
+		item := *leaf
+
+		location, resolveErr := resolveXSPFLocation(base, item.URI)
+		if resolveErr != nil {
+			result.Failed = append(result.Failed, XSPFItemError{Item: item, Err: resolveErr})
+
+			continue
+		}
+
+		item.URI = location
+
+		if _, enqueueErr := v.AddToPlaylistContext(ctx, location); enqueueErr != nil {
+			result.Failed = append(result.Failed, XSPFItemError{Item: item, Err: enqueueErr})
+
+			continue
+		}
+
+		result.Enqueued = append(result.Enqueued, item)
+	}
+
+	return result, nil
+}
+
+// resolveXSPFLocation resolves the (already URI encoded) track location into an absolute URI
+func resolveXSPFLocation(base *url.URL, location string) (string, error) {
+	reference, err := url.Parse(location)
+	if err != nil {
+		return "", fmt.Errorf("%w, %w", errUnresolvableLocation, err)
+	}
+
+	if reference.IsAbs() {
+		return location, nil
+	}
+
+	if base == nil {
+		return "", fmt.Errorf("%w, relative location without a base URI, %s", errUnresolvableLocation, location)
+	}
+
+	return base.ResolveReference(reference).String(), nil
+}
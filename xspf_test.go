@@ -0,0 +1,260 @@
+package vlc
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// vlcXSPF is an XSPF playlist, as saved by VLC
+const vlcXSPF = `<?xml version="1.0" encoding="UTF-8"?>
+<playlist xmlns="http://xspf.org/ns/0/" xmlns:vlc="http://www.videolan.org/vlc/playlist/ns/0/" version="1">
+	<title>Playlist</title>
+	<trackList>
+		<track>
+			<location>file:///media/intro.mp4</location>
+			<title>intro</title>
+			<duration>42000</duration>
+			<extension application="http://www.videolan.org/vlc/playlist/0">
+				<vlc:id>0</vlc:id>
+				<vlc:option>start-time=10</vlc:option>
+			</extension>
+		</track>
+		<track>
+			<location>file:///media/track%201.mp3</location>
+			<title>track 1</title>
+			<extension application="http://www.videolan.org/vlc/playlist/0">
+				<vlc:id>1</vlc:id>
+			</extension>
+		</track>
+		<track>
+			<location>music/outside.mp3</location>
+		</track>
+	</trackList>
+	<extension application="http://www.videolan.org/vlc/playlist/0">
+		<vlc:node title="album">
+			<vlc:item tid="1"/>
+		</vlc:node>
+		<vlc:item tid="0"/>
+	</extension>
+</playlist>
+`
+
+func TestParseXSPF(t *testing.T) {
+	t.Parallel()
+
+	t.Run("VLC playlist", func(t *testing.T) {
+		t.Parallel()
+
+		playlist, err := ParseXSPF(strings.NewReader(vlcXSPF))
+		require.NoError(t, err)
+
+		assert.Equal(t, &Playlist{
+			Type: playlistTypeNode,
+			Name: "Playlist",
+			Children: []Playlist{
+				{
+					Type: playlistTypeNode,
+					Name: "album",
+					Children: []Playlist{
+						{Type: playlistTypeLeaf, Name: "track 1", URI: "file:///media/track%201.mp3", Duration: -1},
+					},
+				},
+				{Type: playlistTypeLeaf, Name: "intro", URI: "file:///media/intro.mp4", Duration: 42},
+				// Not part of the tree, so added at the root level
+				{Type: playlistTypeLeaf, URI: "music/outside.mp3", Duration: -1},
+			},
+		}, playlist)
+	})
+
+	t.Run("plain XSPF", func(t *testing.T) {
+		t.Parallel()
+
+		input := `<playlist version="1"><trackList>` +
+			`<track><location>http://example.com/a.mp3</location></track>` +
+			`<track><location>http://example.com/b.mp3</location></track>` +
+			`</trackList></playlist>`
+
+		playlist, err := ParseXSPF(strings.NewReader(input))
+		require.NoError(t, err)
+
+		require.Len(t, playlist.Leaves(), 2)
+		assert.Equal(t, "http://example.com/b.mp3", playlist.Children[1].URI)
+	})
+
+	t.Run("invalid XML", func(t *testing.T) {
+		t.Parallel()
+
+		playlist, err := ParseXSPF(strings.NewReader("<playlist><trackList>"))
+
+		assert.Nil(t, playlist)
+		assert.Error(t, err)
+	})
+}
+
+func TestWriteXSPF(t *testing.T) {
+	t.Parallel()
+
+	tree := newTestTree()
+	tree.Children[0].Children[0].Duration = 42
+
+	var buf bytes.Buffer
+
+	require.NoError(t, WriteXSPF(&buf, tree))
+
+	output := buf.String()
+
+	assert.True(t, strings.HasPrefix(output, `<?xml version="1.0" encoding="UTF-8"?>`))
+	assert.Contains(t, output, `xmlns:vlc="http://www.videolan.org/vlc/playlist/ns/0/"`)
+	assert.Contains(t, output, `<vlc:node title="album">`)
+	assert.Contains(t, output, `<vlc:item tid="1"></vlc:item>`)
+	assert.Contains(t, output, `<vlc:id>3</vlc:id>`)
+	assert.Contains(t, output, `<duration>42000</duration>`)
+
+	// The title and track list precede the extension
+	assert.Less(t, strings.Index(output, "<trackList>"), strings.Index(output, `<extension application`))
+
+	// The export is parsed back into the same tree, without the IDs and the current flag
+	playlist, err := ParseXSPF(&buf)
+	require.NoError(t, err)
+
+	queue := tree.PlaylistNode()
+
+	assert.Equal(t, queue.Name, playlist.Name)
+	require.Len(t, playlist.Children, len(queue.Children))
+
+	album := playlist.Children[1]
+
+	assert.Equal(t, playlistTypeNode, album.Type)
+	assert.Equal(t, "album", album.Name)
+	require.Len(t, album.Children, 2)
+	assert.Equal(t, "file:///media/track2.mp3", album.Children[1].URI)
+
+	assert.Equal(t, int64(42), playlist.Children[0].Duration)
+}
+
+func TestVLC_ExportXSPF(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unable to fetch playlist", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			fetchErr   = errors.New("fetch error")
+			mockClient = &mockClient{
+				getFn: func(string) ([]byte, error) {
+					return nil, fetchErr
+				},
+			}
+		)
+
+		vlc := NewVLC(mockClient)
+
+		assert.ErrorIs(t, vlc.ExportXSPF(&bytes.Buffer{}), fetchErr)
+	})
+
+	t.Run("playlist exported", func(t *testing.T) {
+		t.Parallel()
+
+		mockClient := &mockClient{
+			getFn: func(endpoint string) ([]byte, error) {
+				require.Equal(t, basePlaylist, endpoint)
+
+				return json.Marshal(newTestTree())
+			},
+		}
+
+		vlc := NewVLC(mockClient)
+
+		var buf bytes.Buffer
+
+		require.NoError(t, vlc.ExportXSPF(&buf))
+
+		assert.Equal(t, 4, strings.Count(buf.String(), "<track>"))
+	})
+}
+
+func TestVLC_ImportXSPF(t *testing.T) {
+	t.Parallel()
+
+	t.Run("tracks resolved and enqueued", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			enqueueErr = errors.New("enqueue error")
+			enqueued   = make([]string, 0)
+
+			mockClient = &mockClient{
+				getFn: func(endpoint string) ([]byte, error) {
+					parsed, err := url.Parse(endpoint)
+					require.NoError(t, err)
+
+					input := parsed.Query().Get(inputKey)
+					enqueued = append(enqueued, input)
+
+					if strings.HasSuffix(input, "intro.mp4") {
+						return nil, enqueueErr
+					}
+
+					return json.Marshal(&Status{})
+				},
+			}
+		)
+
+		vlc := NewVLC(mockClient)
+
+		result, err := vlc.ImportXSPF(strings.NewReader(vlcXSPF), "file:///home/user/")
+		require.NoError(t, err)
+
+		// Enqueued flat, in tree order
+		assert.Equal(t, []string{
+			"file:///media/track%201.mp3",
+			"file:///media/intro.mp4",
+			"file:///home/user/music/outside.mp3",
+		}, enqueued)
+
+		require.Len(t, result.Enqueued, 2)
+		assert.Equal(t, "file:///home/user/music/outside.mp3", result.Enqueued[1].URI)
+
+		require.Len(t, result.Failed, 1)
+		assert.Equal(t, "intro", result.Failed[0].Item.Name)
+		assert.ErrorIs(t, &result.Failed[0], enqueueErr)
+	})
+
+	t.Run("relative location without base URI", func(t *testing.T) {
+		t.Parallel()
+
+		mockClient := &mockClient{
+			getFn: func(string) ([]byte, error) {
+				return json.Marshal(&Status{})
+			},
+		}
+
+		vlc := NewVLC(mockClient)
+
+		result, err := vlc.ImportXSPF(strings.NewReader(vlcXSPF), "")
+		require.NoError(t, err)
+
+		assert.Len(t, result.Enqueued, 2)
+
+		require.Len(t, result.Failed, 1)
+		assert.ErrorIs(t, result.Failed[0].Err, errUnresolvableLocation)
+	})
+
+	t.Run("invalid XSPF", func(t *testing.T) {
+		t.Parallel()
+
+		vlc := NewVLC(&mockClient{})
+
+		result, err := vlc.ImportXSPF(strings.NewReader("not xml"), "")
+
+		assert.Nil(t, result)
+		assert.Error(t, err)
+	})
+}